@@ -0,0 +1,54 @@
+package groth16
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// heightPositionalArgs are the positional arguments of the queries for a consensus state height of a client.
+var heightPositionalArgs = []*autocliv1.PositionalArgDescriptor{
+	{ProtoField: "client_id"},
+	{ProtoField: "revision_number"},
+	{ProtoField: "revision_height"},
+}
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface. It exposes the groth16 light client
+// query service as query commands.
+func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "ConsensusStates",
+					Use:            "consensus-states [client-id]",
+					Short:          "Query all consensus states of a groth16 client",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "client_id"}},
+				},
+				{
+					RpcMethod:      "ConsensusState",
+					Use:            "consensus-state [client-id] [revision-number] [revision-height]",
+					Short:          "Query the consensus state of a groth16 client at a height",
+					PositionalArgs: heightPositionalArgs,
+				},
+				{
+					RpcMethod:      "VerifierKeys",
+					Use:            "verifier-keys [client-id]",
+					Short:          "Query the verifier keys of a groth16 client",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "client_id"}},
+				},
+				{
+					RpcMethod:      "PreviousConsensusState",
+					Use:            "previous-consensus-state [client-id] [revision-number] [revision-height]",
+					Short:          "Query the highest consensus state of a groth16 client below a height",
+					PositionalArgs: heightPositionalArgs,
+				},
+				{
+					RpcMethod:      "NextConsensusState",
+					Use:            "next-consensus-state [client-id] [revision-number] [revision-height]",
+					Short:          "Query the lowest consensus state of a groth16 client above a height",
+					PositionalArgs: heightPositionalArgs,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,379 @@
+package groth16
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	proverclient "github.com/celestiaorg/celestia-zkevm-ibc-demo/provers/client"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	FlagGroth16Vk                  = "groth16-vk"
+	FlagProofSystem                = "proof-system"
+	FlagClientState                = "client-state"
+	FlagConsensusState             = "consensus-state"
+	FlagRollupChainID              = "rollup-chain-id"
+	FlagLatestHeight               = "latest-height"
+	FlagStateTransitionVerifierKey = "state-transition-verifier-key"
+	FlagStateMembershipVerifierKey = "state-membership-verifier-key"
+	FlagCodeCommitment             = "code-commitment"
+	FlagGenesisStateRoot           = "genesis-state-root"
+	FlagTrustingPeriod             = "trusting-period"
+	FlagMaxClockDrift              = "max-clock-drift"
+	FlagIcs26RouterAddress         = "ics26-router-address"
+	FlagStateRoot                  = "state-root"
+	FlagTimestamp                  = "timestamp"
+	FlagHeaderHash                 = "header-hash"
+	FlagRevisionNumber             = "revision-number"
+)
+
+// Proof system names accepted by FlagProofSystem.
+const (
+	proofSystemSP1Groth16 = "sp1-groth16"
+	proofSystemMock       = "mock"
+)
+
+// NewTxCmd returns the root tx command for the groth16 light client.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "groth16",
+		Short:                      "groth16 light client transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		newCreateClientCmd(),
+		newUpdateClientCmd(),
+	)
+
+	return txCmd
+}
+
+// newCreateClientCmd defines the command to create a new groth16 light client.
+func newCreateClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-client",
+		Short: "create a new groth16 light client",
+		Long: `create a new groth16 light client. The client state and consensus state are either read from
+protobuf JSON files or built from flags. The verifying key of the proof system is read from the file given by --groth16-vk,
+it is not needed by clients using the mock proof system.
+	- ClientState JSON example: {"chainId":"80087","latestHeight":"10","proofSystem":"PROOF_SYSTEM_SP1_GROTH16","stateTransitionVerifierKey":"0x00...","genesisStateRoot":"<base64>","trustingPeriod":"1209600s","maxClockDrift":"10s","ics26RouterAddress":"<base64>"}
+	- ConsensusState JSON example: {"headerTimestamp":"2025-01-01T00:00:00Z","stateRoot":"<base64>","headerHash":"<base64>"}`,
+		Example: fmt.Sprintf("%s tx groth16 create-client --%s ~/.sp1/circuits/groth16/v4.0.0-rc.3/groth16_vk.bin --%s 80087 --%s 10 --%s 0x00... --%s 0x... --%s 0x... --%s 0x... --%s 0x... --%s 1735689600 --from relayer",
+			version.AppName, FlagGroth16Vk, FlagRollupChainID, FlagLatestHeight, FlagStateTransitionVerifierKey, FlagGenesisStateRoot, FlagIcs26RouterAddress, FlagStateRoot, FlagHeaderHash, FlagTimestamp),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientState, err := clientStateFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := clientState.Validate(); err != nil {
+				return err
+			}
+
+			consensusState, err := consensusStateFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err := consensusState.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagGroth16Vk, "", "path to the gnark serialized SP1 verifying key of the proof system")
+	cmd.Flags().String(FlagProofSystem, proofSystemSP1Groth16, fmt.Sprintf("proof system of the state transition proofs (%s|%s), %s requires a chain built with the groth16mock build tag", proofSystemSP1Groth16, proofSystemMock, proofSystemMock))
+	cmd.Flags().String(FlagClientState, "", "path to a client state JSON file, the client state flags are ignored if set")
+	cmd.Flags().String(FlagConsensusState, "", "path to a consensus state JSON file, the consensus state flags are ignored if set")
+	cmd.Flags().String(FlagRollupChainID, "", "chain ID of the EVM roll-up")
+	cmd.Flags().Uint64(FlagLatestHeight, 0, "latest height of the EVM roll-up")
+	cmd.Flags().Uint64(FlagRevisionNumber, 0, "revision number of the client heights")
+	cmd.Flags().String(FlagStateTransitionVerifierKey, "", "hex encoded SP1 program verifier key hash of the state transition program")
+	cmd.Flags().String(FlagStateMembershipVerifierKey, "", "hex encoded state membership verifier key")
+	cmd.Flags().String(FlagCodeCommitment, "", "hex encoded commitment over the roll-up's source code")
+	cmd.Flags().String(FlagGenesisStateRoot, "", "hex encoded state root of the EVM roll-up's genesis block")
+	cmd.Flags().Duration(FlagTrustingPeriod, DefaultTrustingPeriod, "trusting period of the client")
+	cmd.Flags().Duration(FlagMaxClockDrift, DefaultMaxClockDrift, "maximum duration that header timestamps may be ahead of the block time")
+	cmd.Flags().String(FlagIcs26RouterAddress, "", "hex encoded address of the ICS26Router contract on the EVM roll-up")
+	cmd.Flags().String(FlagStateRoot, "", "hex encoded state root of the EVM roll-up at the latest height")
+	cmd.Flags().String(FlagHeaderHash, "", "hex encoded hash of the EVM block at the latest height")
+	cmd.Flags().Int64(FlagTimestamp, 0, "unix timestamp in seconds of the EVM block at the latest height")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newUpdateClientCmd defines the command to update a groth16 light client with a state transition proof.
+func newUpdateClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-client [client-id] [path/to/prover_response.json]",
+		Short: "update a groth16 light client with a state transition proof",
+		Long: `update a groth16 light client with a state transition proof from the EVM prover. The prover response file
+is the protobuf JSON encoded ProveStateTransitionResponse. The trusted height, newest height and newest state root of
+the header are read from the proven public values. Both heights have the revision number of the queried client state,
+unless --revision-number is set.
+	- Prover response JSON example: {"proof":"<base64>","publicValues":"<base64>"}`,
+		Example: fmt.Sprintf("%s tx groth16 update-client 08-groth16-0 prover_response.json --%s 1735689600 --from relayer", version.AppName, FlagTimestamp),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proverResponse proverclient.ProveStateTransitionResponse
+			if err := readProtoJSONFile(args[1], &proverResponse); err != nil {
+				return fmt.Errorf("failed to read prover response: %w", err)
+			}
+
+			timestamp, err := cmd.Flags().GetInt64(FlagTimestamp)
+			if err != nil {
+				return err
+			}
+
+			revisionNumber, err := revisionNumberFromFlags(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			header, err := NewHeader(proverResponse.Proof, proverResponse.PublicValues, revisionNumber, time.Unix(timestamp, 0))
+			if err != nil {
+				return err
+			}
+			if err := header.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := clienttypes.NewMsgUpdateClient(args[0], header, clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagTimestamp, 0, "unix timestamp in seconds of the EVM block at the newest height")
+	cmd.Flags().Uint64(FlagRevisionNumber, 0, "revision number of the EVM roll-up, overrides the revision number of the client")
+	_ = cmd.MarkFlagRequired(FlagTimestamp)
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// revisionNumberFromFlags returns the revision number given by FlagRevisionNumber. If the flag is not set,
+// the client state is queried and its revision number is returned.
+func revisionNumberFromFlags(cmd *cobra.Command, clientCtx client.Context, clientID string) (uint64, error) {
+	if cmd.Flags().Changed(FlagRevisionNumber) {
+		return cmd.Flags().GetUint64(FlagRevisionNumber)
+	}
+
+	res, err := clienttypes.NewQueryClient(clientCtx).ClientState(cmd.Context(), &clienttypes.QueryClientStateRequest{ClientId: clientID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to query client state of %s, set --%s to skip the query: %w", clientID, FlagRevisionNumber, err)
+	}
+
+	var clientState exported.ClientState
+	if err := clientCtx.InterfaceRegistry.UnpackAny(res.ClientState, &clientState); err != nil {
+		return 0, err
+	}
+	groth16ClientState, ok := clientState.(*ClientState)
+	if !ok {
+		return 0, fmt.Errorf("client %s is not a groth16 client, got client state %T", clientID, clientState)
+	}
+
+	return groth16ClientState.RevisionNumber, nil
+}
+
+// clientStateFromFlags builds the client state from the client state JSON file or from the
+// client state flags. The verifying key is read from the file given by FlagGroth16Vk if it is set.
+func clientStateFromFlags(cmd *cobra.Command) (*ClientState, error) {
+	vkPath, err := cmd.Flags().GetString(FlagGroth16Vk)
+	if err != nil {
+		return nil, err
+	}
+	var groth16Vk []byte
+	if vkPath != "" {
+		groth16Vk, err = os.ReadFile(vkPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read groth16 vk file: %w", err)
+		}
+	}
+
+	clientStatePath, err := cmd.Flags().GetString(FlagClientState)
+	if err != nil {
+		return nil, err
+	}
+	if clientStatePath != "" {
+		var clientState ClientState
+		if err := readProtoJSONFile(clientStatePath, &clientState); err != nil {
+			return nil, fmt.Errorf("failed to read client state: %w", err)
+		}
+		if groth16Vk != nil {
+			clientState.Groth16Vk = groth16Vk
+		}
+		return &clientState, nil
+	}
+
+	chainID, err := cmd.Flags().GetString(FlagRollupChainID)
+	if err != nil {
+		return nil, err
+	}
+	latestHeight, err := cmd.Flags().GetUint64(FlagLatestHeight)
+	if err != nil {
+		return nil, err
+	}
+	revisionNumber, err := cmd.Flags().GetUint64(FlagRevisionNumber)
+	if err != nil {
+		return nil, err
+	}
+	proofSystemName, err := cmd.Flags().GetString(FlagProofSystem)
+	if err != nil {
+		return nil, err
+	}
+	proofSystem, err := parseProofSystem(proofSystemName)
+	if err != nil {
+		return nil, err
+	}
+	stateTransitionVerifierKey, err := cmd.Flags().GetString(FlagStateTransitionVerifierKey)
+	if err != nil {
+		return nil, err
+	}
+	stateMembershipVerifierKey, err := getHexFlag(cmd, FlagStateMembershipVerifierKey)
+	if err != nil {
+		return nil, err
+	}
+	codeCommitment, err := getHexFlag(cmd, FlagCodeCommitment)
+	if err != nil {
+		return nil, err
+	}
+	genesisStateRoot, err := getHexFlag(cmd, FlagGenesisStateRoot)
+	if err != nil {
+		return nil, err
+	}
+	trustingPeriod, err := cmd.Flags().GetDuration(FlagTrustingPeriod)
+	if err != nil {
+		return nil, err
+	}
+	maxClockDrift, err := cmd.Flags().GetDuration(FlagMaxClockDrift)
+	if err != nil {
+		return nil, err
+	}
+	routerAddress, err := cmd.Flags().GetString(FlagIcs26RouterAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(routerAddress) {
+		return nil, fmt.Errorf("invalid %s: %q", FlagIcs26RouterAddress, routerAddress)
+	}
+
+	return NewClientState(
+		chainID,
+		clienttypes.NewHeight(revisionNumber, latestHeight),
+		proofSystem,
+		stateTransitionVerifierKey,
+		stateMembershipVerifierKey,
+		groth16Vk,
+		codeCommitment,
+		genesisStateRoot,
+		trustingPeriod,
+		maxClockDrift,
+		common.HexToAddress(routerAddress),
+	), nil
+}
+
+// consensusStateFromFlags builds the consensus state from the consensus state JSON file or
+// from the consensus state flags.
+func consensusStateFromFlags(cmd *cobra.Command) (*ConsensusState, error) {
+	consensusStatePath, err := cmd.Flags().GetString(FlagConsensusState)
+	if err != nil {
+		return nil, err
+	}
+	if consensusStatePath != "" {
+		var consensusState ConsensusState
+		if err := readProtoJSONFile(consensusStatePath, &consensusState); err != nil {
+			return nil, fmt.Errorf("failed to read consensus state: %w", err)
+		}
+		return &consensusState, nil
+	}
+
+	stateRoot, err := getHexFlag(cmd, FlagStateRoot)
+	if err != nil {
+		return nil, err
+	}
+	headerHash, err := getHexFlag(cmd, FlagHeaderHash)
+	if err != nil {
+		return nil, err
+	}
+	timestamp, err := cmd.Flags().GetInt64(FlagTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewConsensusState(time.Unix(timestamp, 0), stateRoot, headerHash), nil
+}
+
+// parseProofSystem returns the proof system with the given FlagProofSystem name.
+func parseProofSystem(name string) (ProofSystem, error) {
+	switch name {
+	case proofSystemSP1Groth16:
+		return ProofSystem_PROOF_SYSTEM_SP1_GROTH16, nil
+	case proofSystemMock:
+		return ProofSystem_PROOF_SYSTEM_MOCK, nil
+	default:
+		return 0, fmt.Errorf("invalid %s: %q", FlagProofSystem, name)
+	}
+}
+
+// getHexFlag returns the bytes of a hex encoded flag with an optional 0x prefix.
+func getHexFlag(cmd *cobra.Command, flag string) ([]byte, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+	return bz, nil
+}
+
+// readProtoJSONFile unmarshals the protobuf JSON encoded file into the message.
+func readProtoJSONFile(path string, msg proto.Message) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return protojson.Unmarshal(bz, msg)
+}
@@ -0,0 +1,101 @@
+package groth16
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// clientStateQueryServer serves a single client state over the core IBC client query service.
+type clientStateQueryServer struct {
+	clienttypes.UnimplementedQueryServer
+	clientState *codectypes.Any
+}
+
+func (s *clientStateQueryServer) ClientState(context.Context, *clienttypes.QueryClientStateRequest) (*clienttypes.QueryClientStateResponse, error) {
+	return &clienttypes.QueryClientStateResponse{ClientState: s.clientState}, nil
+}
+
+func TestRevisionNumberFromFlags(t *testing.T) {
+	testCases := []struct {
+		name           string
+		revision       uint64
+		flagValue      string
+		tendermint     bool
+		expRevision    uint64
+		expErrContains string
+	}{
+		{"client at revision zero", 0, "", false, 0, ""},
+		{"client at a later revision", 3, "", false, 3, ""},
+		{"flag overrides the client revision", 3, "5", false, 5, ""},
+		{"flag set to zero overrides the client revision", 3, "0", false, 0, ""},
+		{"not a groth16 client", 0, "", true, 0, "not a groth16 client"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := codectypes.NewInterfaceRegistry()
+			RegisterInterfaces(registry)
+			ibctm.RegisterInterfaces(registry)
+
+			var clientState *codectypes.Any
+			var err error
+			if tc.tendermint {
+				clientState, err = codectypes.NewAnyWithValue(&ibctm.ClientState{ChainId: "celestia-1"})
+			} else {
+				clientState, err = codectypes.NewAnyWithValue(&ClientState{ChainId: testChainID, RevisionNumber: tc.revision})
+			}
+			require.NoError(t, err)
+
+			clientCtx := newClientStateQueryContext(t, registry, clientState)
+			cmd := newUpdateClientCmd()
+			cmd.SetContext(context.Background())
+			if tc.flagValue != "" {
+				require.NoError(t, cmd.Flags().Set(FlagRevisionNumber, tc.flagValue))
+			}
+
+			revisionNumber, err := revisionNumberFromFlags(cmd, clientCtx, testClientID)
+			if tc.expErrContains != "" {
+				require.ErrorContains(t, err, tc.expErrContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expRevision, revisionNumber)
+		})
+	}
+}
+
+// newClientStateQueryContext returns a client context whose core IBC client query service returns the
+// client state.
+func newClientStateQueryContext(t *testing.T, registry codectypes.InterfaceRegistry, clientState *codectypes.Any) client.Context {
+	t.Helper()
+
+	grpcCodec := codec.NewProtoCodec(registry).GRPCCodec()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ForceServerCodec(grpcCodec))
+	clienttypes.RegisterQueryServer(server, &clientStateQueryServer{clientState: clientState})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(grpcCodec)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return client.Context{}.WithInterfaceRegistry(registry).WithGRPCClient(conn)
+}
@@ -2,65 +2,44 @@ package groth16
 
 import (
 	"bytes"
-	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
-	"os"
+	"strings"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
-	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/mpt"
-	proverclient "github.com/celestiaorg/celestia-zkevm-ibc-demo/provers/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
-	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 	"github.com/ethereum/go-ethereum/common"
-	ethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/rlp"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
 	Groth16ClientType = ModuleName
 )
 
-// MptProof contains the Merkle Patricia Trie proofs for packet commitment verification.
-// It includes both account and storage proofs from eth_getProof response.
-// Ref: https://eips.ethereum.org/EIPS/eip-1186
-type MptProof struct {
-	// The account proof is used to verify the account state of the ICS26Router contract.
-	AccountProof []hexutil.Bytes `json:"accountProof"`
-	Address      common.Address  `json:"address"`
-	Balance      *hexutil.Big    `json:"balance"`
-	CodeHash     common.Hash     `json:"codeHash"`
-	Nonce        hexutil.Uint64  `json:"nonce"`
-
-	// Storage proof for the packet commitment in ICS26Router contract storage.
-	StorageHash  common.Hash     `json:"storageHash"`
-	StorageProof []hexutil.Bytes `json:"storageProof"`
-	StorageKey   common.Hash     `json:"storageKey"`
-	StorageValue hexutil.Big     `json:"storageValue"`
-}
-
 // ClientState implements the exported.ClientState interface for Groth16 light clients.
 var _ exported.ClientState = (*ClientState)(nil)
 
-// NewClientState creates a new ClientState instance.
-func NewClientState(latestHeight uint64, stateTransitionVerifierKey string, stateMembershipVerifierKey []byte, groth16Vk []byte, codeCommitment []byte, genesisStateRoot []byte) *ClientState {
+// NewClientState creates a new ClientState instance. The revision number of the latest height is the
+// revision number of all client heights.
+func NewClientState(chainID string, latestHeight clienttypes.Height, proofSystem ProofSystem, stateTransitionVerifierKey string, stateMembershipVerifierKey []byte, groth16Vk []byte, codeCommitment []byte, genesisStateRoot []byte, trustingPeriod time.Duration, maxClockDrift time.Duration, ics26RouterAddress common.Address) *ClientState {
 	return &ClientState{
-		LatestHeight:               latestHeight,
+		ChainId:                    chainID,
+		LatestHeight:               latestHeight.RevisionHeight,
+		RevisionNumber:             latestHeight.RevisionNumber,
+		ProofSystem:                proofSystem,
 		CodeCommitment:             codeCommitment,
 		GenesisStateRoot:           genesisStateRoot,
 		StateTransitionVerifierKey: stateTransitionVerifierKey,
 		StateMembershipVerifierKey: stateMembershipVerifierKey,
 		Groth16Vk:                  groth16Vk,
+		TrustingPeriod:             durationpb.New(trustingPeriod),
+		MaxClockDrift:              durationpb.New(maxClockDrift),
+		Ics26RouterAddress:         ics26RouterAddress.Bytes(),
 	}
 }
 
@@ -72,28 +51,101 @@ func (cs *ClientState) ClientType() string {
 // GetLatestClientHeight returns the latest block height of the client state.
 func (cs *ClientState) GetLatestClientHeight() exported.Height {
 	return clienttypes.Height{
-		RevisionNumber: 0,
+		RevisionNumber: cs.RevisionNumber,
 		RevisionHeight: cs.LatestHeight,
 	}
 }
 
-// status returns the status of the groth16 client.
-func (cs *ClientState) status(_ sdktypes.Context, _ storetypes.KVStore, _ codec.BinaryCodec) exported.Status {
+// status returns the status of the groth16 client. The client is frozen if misbehaviour
+// has been detected and expired if the latest consensus state is outside of the trusting period.
+func (cs *ClientState) status(ctx sdktypes.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec) exported.Status {
+	if cs.FrozenHeight != 0 {
+		return exported.Frozen
+	}
+
+	// get latest consensus state from clientStore to check for expiry
+	consState, err := GetConsensusState(clientStore, cdc, cs.GetLatestClientHeight())
+	if err != nil {
+		// if the client state does not have an associated consensus state for its latest height
+		// then it must be expired
+		return exported.Expired
+	}
+
+	if cs.IsExpired(consState.HeaderTimestamp.AsTime(), ctx.BlockTime()) {
+		return exported.Expired
+	}
+
 	return exported.Active
 }
 
-// Validate is a no-op.
+// IsExpired returns whether or not the client has passed the trusting period since the
+// provided header timestamp.
+func (cs *ClientState) IsExpired(latestTimestamp, now time.Time) bool {
+	expirationTime := latestTimestamp.Add(cs.TrustingPeriod.AsDuration())
+	return !expirationTime.After(now)
+}
+
+// Validate performs a basic validation of the client state fields.
 func (cs *ClientState) Validate() error {
+	if strings.TrimSpace(cs.ChainId) == "" {
+		return sdkerrors.Wrap(ErrInvalidChainID, "chain id cannot be empty string")
+	}
+
+	if cs.LatestHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidLatestHeight, "latest height cannot be zero")
+	}
+
+	if _, err := decodeVkeyHash(cs.StateTransitionVerifierKey); err != nil {
+		return sdkerrors.Wrap(err, "invalid state transition verifier key")
+	}
+
+	verifier, err := GetStateTransitionVerifier(cs.ProofSystem)
+	if err != nil {
+		return err
+	}
+	if err := verifier.ValidateVerifyingKey(cs.Groth16Vk); err != nil {
+		return sdkerrors.Wrapf(err, "invalid %s vk", cs.ProofSystem)
+	}
+
+	if len(cs.GenesisStateRoot) != hashLength {
+		return sdkerrors.Wrapf(ErrInvalidStateRoot, "expected genesis state root length %d, got %d", hashLength, len(cs.GenesisStateRoot))
+	}
+
+	if cs.TrustingPeriod == nil || cs.TrustingPeriod.AsDuration() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidTrustingPeriod, "trusting period must be greater than zero")
+	}
+
+	if cs.MaxClockDrift == nil || cs.MaxClockDrift.AsDuration() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidMaxClockDrift, "max clock drift must be greater than zero")
+	}
+
+	if len(cs.Ics26RouterAddress) != common.AddressLength {
+		return sdkerrors.Wrapf(ErrInvalidRouterAddress, "expected address length %d, got %d", common.AddressLength, len(cs.Ics26RouterAddress))
+	}
+
 	return nil
 }
 
+// routerAddress returns the address of the ICS26Router contract whose storage the client verifies proofs against.
+func (cs *ClientState) routerAddress() common.Address {
+	return common.BytesToAddress(cs.Ics26RouterAddress)
+}
+
 // ZeroCustomFields returns a ClientState that is a copy of the current ClientState
 // with all client customizable fields zeroed out.
 func (cs *ClientState) ZeroCustomFields() exported.ClientState {
 	// Copy over all chain-specified fields and leave custom fields empty.
 	return &ClientState{
+		ChainId:                    cs.ChainId,
 		LatestHeight:               cs.LatestHeight,
+		RevisionNumber:             cs.RevisionNumber,
 		StateTransitionVerifierKey: cs.StateTransitionVerifierKey,
+		CodeCommitment:             cs.CodeCommitment,
+		GenesisStateRoot:           cs.GenesisStateRoot,
+		StateMembershipVerifierKey: cs.StateMembershipVerifierKey,
+		Groth16Vk:                  cs.Groth16Vk,
+		ProofSystem:                cs.ProofSystem,
+		Ics26RouterAddress:         cs.Ics26RouterAddress,
 	}
 }
 
@@ -114,119 +166,168 @@ func (cs *ClientState) initialize(ctx sdktypes.Context, cdc codec.BinaryCodec, c
 // The following are modified methods from the v9 IBC Client interface. The idea is to make
 // it easy to update this client once Celestia moves to v9 of IBC
 func (cs *ClientState) verifyMembership(
-	_ sdktypes.Context,
+	ctx sdktypes.Context,
 	clientStore storetypes.KVStore,
 	cdc codec.BinaryCodec,
 	height exported.Height,
-	_ uint64,
-	_ uint64,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
 	proof []byte,
-	_ exported.Path,
+	path exported.Path,
 	value []byte,
+	proofs verifiedProofs,
 ) error {
+	if err := cs.verifyNotExpired(ctx, clientStore, cdc); err != nil {
+		return err
+	}
+
+	// ensure that the delay period has passed since the consensus state was stored
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
 	// Get consensus state for verification
 	consensusState, err := GetConsensusState(clientStore, cdc, height)
 	if err != nil {
 		return fmt.Errorf("failed to get consensus state: %w", err)
 	}
 
-	// Deserialize the MPT proof
-	var deserializedProof MptProof
-	if err := json.Unmarshal(proof, &deserializedProof); err != nil {
-		return fmt.Errorf("failed to deserialize mpt proof: %w", err)
-	}
-
-	// Verify ICS26Router contract account exists in state
-	ICS26RouterAddress := crypto.Keccak256(deserializedProof.Address.Bytes())
-	verifiedAccountState, err := mpt.VerifyMerklePatriciaTrieProof(
-		ethcommon.BytesToHash(consensusState.StateRoot),
-		ICS26RouterAddress,
-		deserializedProof.AccountProof,
-	)
+	ibcPath, err := commitmentPath(path)
 	if err != nil {
-		return fmt.Errorf("inclusion verification failed: %w", err)
+		return err
 	}
 
-	// Reconstruct and verify account state
-	accountState := []any{
-		uint64(deserializedProof.Nonce),
-		deserializedProof.Balance.ToInt().Bytes(),
-		deserializedProof.StorageHash,
-		deserializedProof.CodeHash,
-	}
-	encodedAccountState, err := rlp.EncodeToBytes(accountState)
+	// Deserialize the MPT proof, a single key proof is treated as a batch proof with one storage proof
+	deserializedProof, err := decodeMptProof(proof)
 	if err != nil {
-		return fmt.Errorf("failed to rlp encode reconstructed account value: %w", err)
-	}
-	if !bytes.Equal(verifiedAccountState, encodedAccountState) {
-		return fmt.Errorf("expected account claimed value: %x does not match the verified value: %x",
-			encodedAccountState, verifiedAccountState)
+		return err
 	}
 
-	// Verify packet commitment exists in contract storage
-	commitmentPath := crypto.Keccak256(deserializedProof.StorageKey.Bytes())
-	verifiedValue, err := mpt.VerifyMerklePatriciaTrieProof(
-		deserializedProof.StorageHash,
-		commitmentPath,
-		deserializedProof.StorageProof,
-	)
-	if err != nil {
-		return fmt.Errorf("inclusion verification failed: %w", err)
+	// Verify ICS26Router contract account exists in state, the account proof of a batch proof is
+	// only verified once per transaction
+	if err := proofs.verifyAccountProof(ctx, consensusState.StateRoot, cs.routerAddress(), deserializedProof); err != nil {
+		return err
 	}
 
-	// Verify storage value matches expected
-	expectedValue, err := rlp.EncodeToBytes(value)
-	if err != nil {
-		return fmt.Errorf("failed to encode value: %w", err)
+	// The storage slot is derived from the path so that a proof for a different commitment cannot be used
+	storageKey := commitmentStorageKey(ctx.GasMeter(), ibcPath)
+
+	// Verify packet commitment exists in contract storage, commitments are 32 byte words
+	if len(value) != common.HashLength {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected %d byte commitment, got %d bytes", common.HashLength, len(value))
 	}
-	if !bytes.Equal(verifiedValue, expectedValue) {
-		return fmt.Errorf("verified value: %x does not match the expected value: %x",
-			verifiedValue, expectedValue)
+	// absent storage slots read as zero, so a zero commitment would be proven by an exclusion proof
+	if common.BytesToHash(value) == (common.Hash{}) {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "commitment cannot be zero")
+	}
+
+	if err := verifyStorageWord(ctx, proofs, deserializedProof, storageKey, common.BytesToHash(value)); err != nil {
+		return sdkerrors.Wrapf(err, "path %x", ibcPath)
 	}
 
 	return nil
 }
 
-// verifyNonMembership verifies a proof of the absence of a key in the Merkle tree.
-// It's the same as VerifyMembership, but the value is nil.
+// verifyNonMembership verifies a proof of the absence of a key in the ICS26Router contract storage.
+// It first verifies the account proof of the ICS26Router contract against the state root and then
+// verifies an exclusion proof for the storage slot derived from the path against the storage root.
 func (cs *ClientState) verifyNonMembership(
-	_ sdktypes.Context,
+	ctx sdktypes.Context,
 	clientStore storetypes.KVStore,
 	cdc codec.BinaryCodec,
 	height exported.Height,
-	_ uint64,
-	_ uint64,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
 	proof []byte,
 	path exported.Path,
+	proofs verifiedProofs,
 ) error {
+	if err := cs.verifyNotExpired(ctx, clientStore, cdc); err != nil {
+		return err
+	}
+
+	// ensure that the delay period has passed since the consensus state was stored
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
 	consensusState, err := GetConsensusState(clientStore, cdc, height)
 	if err != nil {
 		return fmt.Errorf("failed to get consensus state: %w", err)
 	}
 
-	merklePath, ok := path.(commitmenttypesv2.MerklePath)
-	if !ok {
-		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	ibcPath, err := commitmentPath(path)
+	if err != nil {
+		return err
 	}
 
-	// NOTE: this verification implementation is not correct, mpt getProof doesn't handle non-membership proofs
-	mptKey := append(merklePath.KeyPath[0], merklePath.KeyPath[1]...)
-
-	var decodedProof MptProof
-	err = json.Unmarshal(proof, &decodedProof)
+	decodedProof, err := decodeMptProof(proof)
 	if err != nil {
-		return fmt.Errorf("failed to deserialize mpt proof: %w", err)
+		return err
 	}
 
-	// Inclusion verification only supports MPT tries currently
-	verifiedValue, err := mpt.VerifyMerklePatriciaTrieProof(ethcommon.BytesToHash(consensusState.StateRoot), mptKey, decodedProof.AccountProof)
-	if err != nil {
-		return fmt.Errorf("inclusion verification failed: %w", err)
+	// Verify ICS26Router contract account exists in state, the account proof of a batch proof is
+	// only verified once per transaction
+	if err := proofs.verifyAccountProof(ctx, consensusState.StateRoot, cs.routerAddress(), decodedProof); err != nil {
+		return err
+	}
+
+	// Verify that the packet commitment is absent from contract storage. The proof may either end in
+	// an empty branch child or in a node whose path diverges from the key, an account without storage
+	// has an empty proof.
+	storageKey := commitmentStorageKey(ctx.GasMeter(), ibcPath)
+	if err := verifyStorageExclusion(ctx, proofs, decodedProof, storageKey); err != nil {
+		return sdkerrors.Wrapf(err, "path %x", ibcPath)
+	}
+
+	return nil
+}
+
+// verifyNotExpired returns an error if the latest consensus state of the client is outside
+// of the trusting period.
+func (cs *ClientState) verifyNotExpired(ctx sdktypes.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec) error {
+	if status := cs.status(ctx, clientStore, cdc); status == exported.Expired {
+		return sdkerrors.Wrapf(ErrTrustingPeriodExpired, "cannot verify proof against expired client with trusting period %s", cs.TrustingPeriod.AsDuration())
 	}
 
-	// if verifiedValue is not nil error
-	if verifiedValue != nil {
-		return fmt.Errorf("the value for the specified key exists: %w", err)
+	return nil
+}
+
+// verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
+// since consensus state was submitted before allowing verification to continue.
+func verifyDelayPeriodPassed(ctx sdktypes.Context, clientStore storetypes.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
+	if delayTimePeriod != 0 {
+		// check that executing chain's timestamp has passed consensusState's processed time + delay time period
+		processedTime, ok := GetProcessedTime(clientStore, proofHeight)
+		if !ok {
+			return sdkerrors.Wrapf(ErrProcessedTimeNotFound, "processed time not found for height: %s", proofHeight)
+		}
+
+		currentTimestamp := uint64(ctx.BlockTime().UnixNano())
+		validTime := processedTime + delayTimePeriod
+
+		// NOTE: delay time period is inclusive, so if currentTimestamp is validTime, then we return no error
+		if currentTimestamp < validTime {
+			return sdkerrors.Wrapf(ErrDelayPeriodNotPassed, "cannot verify packet until time: %d, current time: %d",
+				validTime, currentTimestamp)
+		}
+	}
+
+	if delayBlockPeriod != 0 {
+		// check that executing chain's height has passed consensusState's processed height + delay block period
+		processedHeight, ok := GetProcessedHeight(clientStore, proofHeight)
+		if !ok {
+			return sdkerrors.Wrapf(ErrProcessedHeightNotFound, "processed height not found for height: %s", proofHeight)
+		}
+
+		currentHeight := clienttypes.GetSelfHeight(ctx)
+		validHeight := clienttypes.NewHeight(processedHeight.GetRevisionNumber(), processedHeight.GetRevisionHeight()+delayBlockPeriod)
+
+		// NOTE: delay block period is inclusive, so if currentHeight is validHeight, then we return no error
+		if currentHeight.LT(validHeight) {
+			return sdkerrors.Wrapf(ErrDelayPeriodNotPassed, "cannot verify packet until height: %s, current height: %s",
+				validHeight, currentHeight)
+		}
 	}
 
 	return nil
@@ -241,61 +342,184 @@ func (cs *ClientState) getTimestampAtHeight(clientStore storetypes.KVStore, cdc
 	return consensusState.GetTimestamp(), nil
 }
 
-// CheckForMisbehaviour is a no-op for groth16
-func (cs *ClientState) CheckForMisbehaviour(ctx sdktypes.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
-	return false
-}
-
-func (cs *ClientState) CheckSubstituteAndUpdateState(ctx sdktypes.Context, cdc codec.BinaryCodec, subjectClientStore, substituteClientStore storetypes.KVStore, substituteClient exported.ClientState) error {
-	return sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "cannot update groth16 client with a proposal")
-}
-
-// VerifyUpgradeAndUpdateState returns an error because it hasn't been
-// implemented yet.
+// VerifyUpgradeAndUpdateState returns an error because groth16 clients cannot be upgraded. The
+// ICS26Router contract has no storage that the rollup commits upgraded client and consensus states
+// to, so a rollup that changes its SP1 program needs a new client. See docs/ARCHITECTURE.md.
 func (cs *ClientState) VerifyUpgradeAndUpdateState(
-	ctx context.Context,
+	ctx sdktypes.Context,
 	cdc codec.BinaryCodec,
 	clientStore storetypes.KVStore,
 	upgradedClient exported.ClientState,
 	upgradedConsState exported.ConsensusState,
-	proofUpgradeClient,
-	proofUpgradeConsState []byte,
+	upgradeClientProof,
+	upgradeConsStateProof []byte,
 ) error {
-	return errors.New("not implemented")
+	return sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "groth16 clients do not support upgrades")
 }
 
-// VerifyClientMessage checks if the clientMessage is of type Header
+// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
 func (cs *ClientState) VerifyClientMessage(ctx sdktypes.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) error {
 	switch msg := clientMsg.(type) {
 	case *Header:
 		return cs.verifyHeader(ctx, clientStore, cdc, msg)
+	case *Misbehaviour:
+		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg)
 	default:
 		return clienttypes.ErrInvalidClientType
 	}
 }
 
-func (cs *ClientState) verifyHeader(_ sdktypes.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec, header *Header) error {
+func (cs *ClientState) verifyHeader(ctx sdktypes.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec, header *Header) error {
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+
 	// get consensus state from clientStore for trusted height
-	_, err := GetConsensusState(clientStore, cdc, clienttypes.NewHeight(0, uint64(header.TrustedHeight)))
+	// headers must be for the current revision of the roll-up, a new revision requires recovering the client with a substitute client
+	if header.GetHeight().GetRevisionNumber() != cs.RevisionNumber {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeaderHeight,
+			"header revision %d does not match client revision %d", header.GetHeight().GetRevisionNumber(), cs.RevisionNumber,
+		)
+	}
+
+	trustedConsState, err := GetConsensusState(clientStore, cdc, header.TrustedHeight.clientHeight())
 	if err != nil {
 		return sdkerrors.Wrapf(
-			err, "could not get consensus state from clientstore at TrustedHeight: %d", header.TrustedHeight,
+			err, "could not get consensus state from clientstore at TrustedHeight: %s", header.TrustedHeight.clientHeight(),
+		)
+	}
+
+	// updates must be built on top of a consensus state that is still within the trusting period
+	if cs.IsExpired(trustedConsState.HeaderTimestamp.AsTime(), ctx.BlockTime()) {
+		return sdkerrors.Wrapf(
+			ErrTrustingPeriodExpired,
+			"trusted consensus state at height %s with timestamp %s has expired (trusting period %s, block time %s)",
+			header.TrustedHeight.clientHeight(), trustedConsState.HeaderTimestamp.AsTime(), cs.TrustingPeriod.AsDuration(), ctx.BlockTime(),
 		)
 	}
 
 	// assert header height is newer than consensus state
-	if header.GetHeight().LTE(clienttypes.NewHeight(0, uint64(header.TrustedHeight))) {
+	if header.GetHeight().LTE(header.TrustedHeight.clientHeight()) {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrInvalidHeader,
-			"header height ≤ consensus state height (%d ≤ %d)", header.GetHeight(), header.TrustedHeight,
+			"header height ≤ consensus state height (%s ≤ %s)", header.GetHeight(), header.TrustedHeight.clientHeight(),
+		)
+	}
+
+	if err := verifyPublicValues(header, trustedConsState); err != nil {
+		return err
+	}
+
+	if err := cs.verifyHeaderTimestamp(ctx, clientStore, cdc, header, trustedConsState); err != nil {
+		return err
+	}
+
+	moduleLogger(ctx).Debug("verifying groth16 state transition proof", "trusted-height", header.TrustedHeight.clientHeight(), "new-height", header.GetHeight())
+	return cs.verifyStateTransitionProof(ctx, header)
+}
+
+// verifyPublicValues asserts that the header fields provided by the relayer match the public
+// values committed to by the state transition proof and that the proven range of EVM blocks
+// starts immediately after the trusted consensus state.
+func verifyPublicValues(header *Header, trustedConsState *ConsensusState) error {
+	output, err := DecodePublicValues(header.PublicValues)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHeader, "failed to decode public values: %v", err)
+	}
+
+	if !bytes.Equal(header.NewestStateRoot, output.NewestStateRoot[:]) {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeader,
+			"header state root %X does not match proven state root %X", header.NewestStateRoot, output.NewestStateRoot,
+		)
+	}
+
+	if header.GetHeight().GetRevisionHeight() != output.NewestHeight {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeader,
+			"header height %d does not match proven height %d", header.GetHeight().GetRevisionHeight(), output.NewestHeight,
+		)
+	}
+
+	// The aggregator proves one EVM block per Celestia header hash, so the number of blocks
+	// in the proven range must bridge the gap between the trusted height and the new height.
+	numBlocks := uint64(len(output.CelestiaHeaderHashes))
+	if numBlocks == 0 || numBlocks > output.NewestHeight || output.NewestHeight-numBlocks != header.TrustedHeight.GetRevisionHeight() {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeader,
+			"proven range of %d blocks ending at height %d does not start after trusted height %d",
+			numBlocks, output.NewestHeight, header.TrustedHeight.GetRevisionHeight(),
+		)
+	}
+
+	// the aggregator proves that the range of blocks is a chain, so a range whose first block is a
+	// child of the trusted header extends the trusted state
+	if !bytes.Equal(output.TrustedHeaderHash[:], trustedConsState.HeaderHash) {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeader,
+			"proven range of blocks builds on header %X, not on the trusted header %X",
+			output.TrustedHeaderHash, trustedConsState.HeaderHash,
 		)
 	}
 
 	return nil
 }
 
-// UpdateState updates the consensus state and client state.
-func (cs *ClientState) UpdateState(ctx sdktypes.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) ([]exported.Height, error) {
+// verifyHeaderTimestamp asserts that the header timestamp is not ahead of the block time by more than the
+// max clock drift and that it lies strictly between the timestamps of the consensus states before and
+// after the header height. The timestamp is not committed to by the state transition proof, so it is
+// bounded by the trusted consensus states instead.
+func (cs *ClientState) verifyHeaderTimestamp(ctx sdktypes.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec, header *Header, trustedConsState *ConsensusState) error {
+	timestamp := header.Timestamp.AsTime()
+
+	// a header that attests to the same state as the stored consensus state must be an exact duplicate,
+	// otherwise replaying it with a different timestamp would change the timestamp at its height
+	if existingConsState, err := GetConsensusState(clientStore, cdc, header.GetHeight()); err == nil && isSameConsensusState(existingConsState, header) {
+		if !timestamp.Equal(existingConsState.HeaderTimestamp.AsTime()) {
+			return sdkerrors.Wrapf(
+				ErrInvalidHeader,
+				"header timestamp %s does not match the timestamp %s of the consensus state at height %s",
+				timestamp, existingConsState.HeaderTimestamp.AsTime(), header.GetHeight(),
+			)
+		}
+		return nil
+	}
+
+	maxTimestamp := ctx.BlockTime().Add(cs.MaxClockDrift.AsDuration())
+	if timestamp.After(maxTimestamp) {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeader,
+			"header timestamp %s is ahead of block time %s by more than the max clock drift %s", timestamp, ctx.BlockTime(), cs.MaxClockDrift.AsDuration(),
+		)
+	}
+
+	// consensus states between the trusted height and the header height are newer than the trusted one
+	prevConsState := trustedConsState
+	if consState, found := GetPreviousConsensusState(clientStore, cdc, header.GetHeight()); found {
+		prevConsState = consState
+	}
+	if !timestamp.After(prevConsState.HeaderTimestamp.AsTime()) {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeader,
+			"header timestamp %s must be after the timestamp %s of the previous consensus state", timestamp, prevConsState.HeaderTimestamp.AsTime(),
+		)
+	}
+
+	// headers may fill in consensus states before the latest height
+	if nextConsState, found := GetNextConsensusState(clientStore, cdc, header.GetHeight()); found && !timestamp.Before(nextConsState.HeaderTimestamp.AsTime()) {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeader,
+			"header timestamp %s must be before the timestamp %s of the next consensus state", timestamp, nextConsState.HeaderTimestamp.AsTime(),
+		)
+	}
+
+	return nil
+}
+
+// UpdateState updates the consensus state and client state. Update and prune events are emitted
+// for the client with the given client identifier.
+func (cs *ClientState) UpdateState(ctx sdktypes.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientID string, clientMsg exported.ClientMessage) ([]exported.Height, error) {
 	header, ok := clientMsg.(*Header)
 	if !ok {
 		return []exported.Height{}, fmt.Errorf("the only supported clientMsg type is Header")
@@ -306,51 +530,81 @@ func (cs *ClientState) UpdateState(ctx sdktypes.Context, cdc codec.BinaryCodec,
 		return []exported.Height{}, fmt.Errorf("invalid height type %T", header.GetHeight())
 	}
 
-	evmProverRPC := os.Getenv("EVM_PROVER_URL")
-	conn, err := grpc.NewClient(evmProverRPC, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to prover: %w", err)
+	// a consensus state that matches the header has already been stored, so the update is a no-op
+	if consensusState, err := GetConsensusState(clientStore, cdc, height); err == nil && isSameConsensusState(consensusState, header) {
+		return []exported.Height{height}, nil
 	}
-	defer conn.Close()
-	client := proverclient.NewProverClient(conn)
 
-	verifyProofRequest := &proverclient.VerifyProofRequest{
-		Proof:           header.StateTransitionProof,
-		Sp1PublicInputs: header.PublicValues,
-		Sp1VkeyHash:     cs.StateTransitionVerifierKey,
-		Groth16Vk:       cs.Groth16Vk,
+	// prune expired consensus states so that the client store does not grow without bound
+	logger := moduleLogger(ctx).With("client-id", clientID)
+	prunedHeights := pruneExpiredConsensusStates(ctx, clientStore, cdc, cs, MaxPrunedConsensusStatesPerUpdate)
+	if len(prunedHeights) > 0 {
+		logger.Info("pruned expired consensus states", "count", len(prunedHeights), "height", prunedHeights[len(prunedHeights)-1])
+		if err := emitPruneConsensusStatesEvent(ctx, clientID, prunedHeights); err != nil {
+			return []exported.Height{}, err
+		}
 	}
 
-	fmt.Printf("Verifying groth16 state transition proof from height: %d to height: %d\n", header.GetHeight(), header.NewestHeight)
-	verifyProofResponse, err := client.VerifyProof(ctx, verifyProofRequest)
+	output, err := DecodePublicValues(header.PublicValues)
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify proof: %w", err)
-	}
-	fmt.Println(verifyProofResponse, "verify proof response")
-
-	if !verifyProofResponse.Success {
-		return nil, fmt.Errorf("proof verification failed")
+		return []exported.Height{}, sdkerrors.Wrapf(ErrInvalidHeader, "failed to decode public values: %v", err)
 	}
 
 	newConsensusState := &ConsensusState{
 		HeaderTimestamp: header.Timestamp,
 		StateRoot:       header.NewestStateRoot,
+		HeaderHash:      output.NewestHeaderHash[:],
 	}
 
-	fmt.Printf("Setting new consensus state with state root: %X and height: %v and timestamp: %v\n", newConsensusState.StateRoot, header.GetHeight(), newConsensusState.HeaderTimestamp)
+	logger.Info("setting new consensus state", "height", height, "state-root", fmt.Sprintf("%X", newConsensusState.StateRoot), "timestamp", newConsensusState.HeaderTimestamp.AsTime())
 	SetConsensusState(clientStore, cdc, newConsensusState, header.GetHeight())
 	setConsensusMetadata(ctx, clientStore, header.GetHeight())
 
+	// only update the latest height if the header is newer than the latest height, headers
+	// may fill in consensus states at heights between existing consensus states
+	latestHeight := cs.LatestHeight
+	if height.GT(cs.GetLatestClientHeight()) {
+		latestHeight = height.GetRevisionHeight()
+	}
+
 	newClientState := &ClientState{
-		LatestHeight:               header.GetHeight().GetRevisionHeight(),
+		ChainId:                    cs.ChainId,
+		LatestHeight:               latestHeight,
+		RevisionNumber:             cs.RevisionNumber,
 		CodeCommitment:             cs.CodeCommitment,
 		GenesisStateRoot:           cs.GenesisStateRoot,
 		StateTransitionVerifierKey: cs.StateTransitionVerifierKey,
 		StateMembershipVerifierKey: cs.StateMembershipVerifierKey,
 		Groth16Vk:                  cs.Groth16Vk,
+		ProofSystem:                cs.ProofSystem,
+		FrozenHeight:               cs.FrozenHeight,
+		TrustingPeriod:             cs.TrustingPeriod,
+		MaxClockDrift:              cs.MaxClockDrift,
+		Ics26RouterAddress:         cs.Ics26RouterAddress,
 	}
-	fmt.Printf("Setting new client state with height: %v\n", newClientState.LatestHeight)
+	logger.Info("setting new client state", "latest-height", newClientState.LatestHeight)
 	setClientState(clientStore, cdc, newClientState)
 
+	if err := emitUpdateClientEvent(ctx, clientID, cs.GetLatestClientHeight(), height, header); err != nil {
+		return []exported.Height{}, err
+	}
+
 	return []exported.Height{height}, nil
 }
+
+// verifyStateTransitionProof verifies the state transition proof of the header against the
+// client's verifier keys with the verifier of the client's proof system. The gas of the pairing
+// checks is charged before the proof is verified.
+func (cs *ClientState) verifyStateTransitionProof(ctx sdktypes.Context, header *Header) error {
+	verifier, err := GetStateTransitionVerifier(cs.ProofSystem)
+	if err != nil {
+		return err
+	}
+	consumePairingGas(ctx.GasMeter(), verifier.PairingChecks())
+
+	if err := verifier.VerifyStateTransitionProof(header.StateTransitionProof, header.PublicValues, cs.StateTransitionVerifierKey, cs.Groth16Vk); err != nil {
+		return fmt.Errorf("failed to verify proof: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,392 @@
+package groth16
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/mpt"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestVerifyHeader(t *testing.T) {
+	timestamp := testGenesisTime.Add(time.Minute)
+	blockTime := testGenesisTime.Add(time.Hour)
+
+	testCases := []struct {
+		name   string
+		header func(t *testing.T) *Header
+		expErr error
+	}{
+		{
+			"success: single block",
+			func(t *testing.T) *Header {
+				return newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp)
+			},
+			nil,
+		},
+		{
+			"success: range of blocks",
+			func(t *testing.T) *Header {
+				return newTestHeader(t, testTrustedHeight, testTrustedHeight+5, timestamp)
+			},
+			nil,
+		},
+		{
+			"range does not build on the trusted header",
+			func(t *testing.T) *Header {
+				output := newTestOutput(testTrustedHeight, testTrustedHeight+1)
+				copy(output.TrustedHeaderHash[:], testHeaderHash(testTrustedHeight-1))
+				return newTestHeaderFromOutput(t, output, timestamp)
+			},
+			ErrInvalidHeader,
+		},
+		{
+			"header state root does not match proven state root",
+			func(t *testing.T) *Header {
+				header := newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp)
+				header.NewestStateRoot = testStateRoot(testTrustedHeight + 2)
+				return header
+			},
+			ErrInvalidHeader,
+		},
+		{
+			"proven range does not start after the trusted height",
+			func(t *testing.T) *Header {
+				output := newTestOutput(testTrustedHeight, testTrustedHeight+2)
+				output.CelestiaHeaderHashes = output.CelestiaHeaderHashes[1:]
+				header := newTestHeaderFromOutput(t, output, timestamp)
+				header.TrustedHeight.RevisionHeight = testTrustedHeight
+				return header
+			},
+			ErrInvalidHeader,
+		},
+		{
+			"success: timestamp within max clock drift of block time",
+			func(t *testing.T) *Header {
+				return newTestHeader(t, testTrustedHeight, testTrustedHeight+1, blockTime.Add(DefaultMaxClockDrift))
+			},
+			nil,
+		},
+		{
+			"timestamp beyond max clock drift of block time",
+			func(t *testing.T) *Header {
+				return newTestHeader(t, testTrustedHeight, testTrustedHeight+1, blockTime.Add(DefaultMaxClockDrift+time.Second))
+			},
+			ErrInvalidHeader,
+		},
+		{
+			"timestamp equal to trusted consensus state timestamp",
+			func(t *testing.T) *Header {
+				return newTestHeader(t, testTrustedHeight, testTrustedHeight+1, testGenesisTime)
+			},
+			ErrInvalidHeader,
+		},
+		{
+			"non-empty mock proof",
+			func(t *testing.T) *Header {
+				header := newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp)
+				header.StateTransitionProof = []byte{0x1}
+				return header
+			},
+			ErrInvalidStateTransitionProof,
+		},
+		{
+			"timestamp before trusted consensus state timestamp",
+			func(t *testing.T) *Header {
+				return newTestHeader(t, testTrustedHeight, testTrustedHeight+1, testGenesisTime.Add(-time.Second))
+			},
+			ErrInvalidHeader,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t)
+
+			err := c.module.VerifyClientMessage(c.ctx, testClientID, tc.header(t))
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestClientStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(t *testing.T, clientState *ClientState)
+		expErr   error
+	}{
+		{
+			"success: mock proof system",
+			func(*testing.T, *ClientState) {},
+			nil,
+		},
+		{
+			"success: sp1 groth16 proof system",
+			func(t *testing.T, clientState *ClientState) {
+				clientState.ProofSystem = ProofSystem_PROOF_SYSTEM_SP1_GROTH16
+				clientState.Groth16Vk = newGroth16Fixture(t, testVkeyHash, testPublicValues()).Groth16Vk
+			},
+			nil,
+		},
+		{
+			"mock proof system without the groth16mock build tag",
+			func(t *testing.T, _ *ClientState) {
+				setMockProofSystem(t, false)
+			},
+			ErrInvalidProofSystem,
+		},
+		{
+			"empty chain id",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.ChainId = " "
+			},
+			ErrInvalidChainID,
+		},
+		{
+			"zero latest height",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.LatestHeight = 0
+			},
+			ErrInvalidLatestHeight,
+		},
+		{
+			"verifier key hash is not hex encoded",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.StateTransitionVerifierKey = "not a verifier key"
+			},
+			ErrInvalidVerifierKey,
+		},
+		{
+			"verifier key hash is too short",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.StateTransitionVerifierKey = testVkeyHash[:len(testVkeyHash)-2]
+			},
+			ErrInvalidVerifierKey,
+		},
+		{
+			"unknown proof system",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.ProofSystem = ProofSystem(100)
+			},
+			ErrInvalidProofSystem,
+		},
+		{
+			"unsupported sp1 plonk proof system",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.ProofSystem = ProofSystem_PROOF_SYSTEM_SP1_PLONK
+			},
+			ErrInvalidProofSystem,
+		},
+		{
+			"invalid sp1 groth16 vk",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.ProofSystem = ProofSystem_PROOF_SYSTEM_SP1_GROTH16
+				clientState.Groth16Vk = []byte("not a groth16 vk")
+			},
+			ErrInvalidVerifierKey,
+		},
+		{
+			"genesis state root of wrong length",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.GenesisStateRoot = clientState.GenesisStateRoot[1:]
+			},
+			ErrInvalidStateRoot,
+		},
+		{
+			"nil trusting period",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.TrustingPeriod = nil
+			},
+			ErrInvalidTrustingPeriod,
+		},
+		{
+			"zero trusting period",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.TrustingPeriod = durationpb.New(0)
+			},
+			ErrInvalidTrustingPeriod,
+		},
+		{
+			"zero max clock drift",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.MaxClockDrift = durationpb.New(0)
+			},
+			ErrInvalidMaxClockDrift,
+		},
+		{
+			"router address of wrong length",
+			func(_ *testing.T, clientState *ClientState) {
+				clientState.Ics26RouterAddress = append(clientState.Ics26RouterAddress, 0)
+			},
+			ErrInvalidRouterAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setMockProofSystem(t, true)
+			clientState := newTestClientState()
+			tc.malleate(t, clientState)
+
+			err := clientState.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestUpdateStateChainsHeaderHashes(t *testing.T) {
+	c := newTestClient(t)
+
+	c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+2, testGenesisTime.Add(time.Minute)))
+	require.Equal(t, testHeaderHash(testTrustedHeight+2), c.consensusState(t, testTrustedHeight+2).HeaderHash)
+	require.Equal(t, uint64(testTrustedHeight+2), c.clientState(t).LatestHeight)
+
+	// the next update builds on the header of the new consensus state
+	c.update(t, newTestHeader(t, testTrustedHeight+2, testTrustedHeight+3, testGenesisTime.Add(2*time.Minute)))
+	require.Equal(t, testHeaderHash(testTrustedHeight+3), c.consensusState(t, testTrustedHeight+3).HeaderHash)
+}
+
+func TestVerifyHeaderFillsInTimestampGap(t *testing.T) {
+	c := newTestClient(t)
+	c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+4, testGenesisTime.Add(4*time.Minute)))
+
+	// a header between two consensus states must have a timestamp between theirs
+	header := newTestHeader(t, testTrustedHeight, testTrustedHeight+2, testGenesisTime.Add(4*time.Minute))
+	require.ErrorIs(t, c.module.VerifyClientMessage(c.ctx, testClientID, header), ErrInvalidHeader)
+
+	c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+2, testGenesisTime.Add(2*time.Minute)))
+
+	// the timestamp must also be after the consensus state between the trusted and the header height
+	header = newTestHeader(t, testTrustedHeight, testTrustedHeight+3, testGenesisTime.Add(time.Minute))
+	require.ErrorIs(t, c.module.VerifyClientMessage(c.ctx, testClientID, header), ErrInvalidHeader)
+
+	c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+3, testGenesisTime.Add(3*time.Minute)))
+}
+
+func TestVerifyMembershipRejectsZeroValue(t *testing.T) {
+	c := newTestClient(t)
+
+	path := []byte("07-tendermint-0\x01\x00\x00\x00\x00\x00\x00\x00\x01")
+	storageKey := CommitmentStorageKey(path)
+	fixture, err := mpt.GenerateRouterFixture(testRouterAddress, nil, 8, storageKey)
+	require.NoError(t, err)
+	c.setStateRoot(t, fixture.StateRoot)
+
+	proof, err := json.Marshal(fixture.Proof)
+	require.NoError(t, err)
+
+	merklePath := commitmenttypesv2.NewMerklePath(path)
+	height := clienttypes.NewHeight(0, testTrustedHeight)
+
+	// the exclusion proof of the absent slot proves its value is zero
+	require.NoError(t, c.module.VerifyNonMembership(c.ctx, testClientID, height, 0, 0, proof, merklePath))
+	err = c.module.VerifyMembership(c.ctx, testClientID, height, 0, 0, proof, merklePath, make([]byte, common.HashLength))
+	require.ErrorIs(t, err, commitmenttypes.ErrInvalidProof)
+	err = c.module.VerifyMembership(c.ctx, testClientID, height, 0, 0, proof, merklePath, nil)
+	require.ErrorIs(t, err, commitmenttypes.ErrInvalidProof)
+}
+
+func TestVerifyMembershipDelayPeriod(t *testing.T) {
+	const (
+		delayTime   = uint64(time.Hour)
+		delayBlocks = uint64(5)
+	)
+
+	testCases := []struct {
+		name             string
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		// elapsedTime and elapsedBlocks are the time and number of blocks since the consensus state was processed
+		elapsedTime   time.Duration
+		elapsedBlocks int64
+		expErr        error
+	}{
+		{"no delay period", 0, 0, 0, 0, nil},
+		{"delay time period not passed", delayTime, 0, time.Hour - time.Nanosecond, 0, ErrDelayPeriodNotPassed},
+		{"delay time period passed", delayTime, 0, time.Hour, 0, nil},
+		{"delay block period not passed", 0, delayBlocks, 0, 4, ErrDelayPeriodNotPassed},
+		{"delay block period passed", 0, delayBlocks, 0, 5, nil},
+		{"delay time period passed but not delay block period", delayTime, delayBlocks, time.Hour, 4, ErrDelayPeriodNotPassed},
+		{"delay block period passed but not delay time period", delayTime, delayBlocks, time.Minute, 5, ErrDelayPeriodNotPassed},
+		{"both delay periods passed", delayTime, delayBlocks, 2 * time.Hour, 10, nil},
+	}
+
+	fixture := loadMptFixture(t)
+	present := commitmenttypesv2.NewMerklePath(fixture.Paths[0])
+	absent := commitmenttypesv2.NewMerklePath(fixture.Paths[len(fixture.Paths)-1])
+	var batchProof MptBatchProofJSON
+	require.NoError(t, json.Unmarshal(fixture.Proof, &batchProof))
+	value := common.BigToHash(batchProof.StorageProof[0].Value.ToInt()).Bytes()
+	height := clienttypes.NewHeight(0, testTrustedHeight)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t)
+			c.setStateRoot(t, fixture.StateRoot)
+
+			processedTime, found := GetProcessedTime(c.clientStore(), height)
+			require.True(t, found)
+			processedHeight, found := GetProcessedHeight(c.clientStore(), height)
+			require.True(t, found)
+
+			ctx := c.ctx.
+				WithBlockTime(time.Unix(0, int64(processedTime)).Add(tc.elapsedTime)).
+				WithBlockHeight(int64(processedHeight.GetRevisionHeight()) + tc.elapsedBlocks)
+
+			errMembership := c.module.VerifyMembership(ctx, testClientID, height, tc.delayTimePeriod, tc.delayBlockPeriod, fixture.Proof, present, value)
+			errNonMembership := c.module.VerifyNonMembership(ctx, testClientID, height, tc.delayTimePeriod, tc.delayBlockPeriod, fixture.Proof, absent)
+			if tc.expErr == nil {
+				require.NoError(t, errMembership)
+				require.NoError(t, errNonMembership)
+			} else {
+				require.ErrorIs(t, errMembership, tc.expErr)
+				require.ErrorIs(t, errNonMembership, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestVerifyMembershipDelayPeriodMissingMetadata(t *testing.T) {
+	fixture := loadMptFixture(t)
+	path := commitmenttypesv2.NewMerklePath(fixture.Paths[len(fixture.Paths)-1])
+	height := clienttypes.NewHeight(0, testTrustedHeight)
+
+	c := newTestClient(t)
+	c.setStateRoot(t, fixture.StateRoot)
+	deleteProcessedTime(c.clientStore(), height)
+	deleteProcessedHeight(c.clientStore(), height)
+
+	// proofs without a delay period do not need the processed time and height
+	require.NoError(t, c.module.VerifyNonMembership(c.ctx, testClientID, height, 0, 0, fixture.Proof, path))
+
+	err := c.module.VerifyNonMembership(c.ctx, testClientID, height, 1, 0, fixture.Proof, path)
+	require.ErrorIs(t, err, ErrProcessedTimeNotFound)
+	err = c.module.VerifyNonMembership(c.ctx, testClientID, height, 0, 1, fixture.Proof, path)
+	require.ErrorIs(t, err, ErrProcessedHeightNotFound)
+}
+
+func TestVerifyUpgradeAndUpdateStateUnsupported(t *testing.T) {
+	c := newTestClient(t)
+
+	upgradedClient := c.clientState(t)
+	upgradedClient.LatestHeight++
+	upgradedConsState := NewConsensusState(testGenesisTime, testStateRoot(testTrustedHeight+1), testHeaderHash(testTrustedHeight+1))
+
+	err := c.module.VerifyUpgradeAndUpdateState(c.ctx, testClientID, c.cdc.MustMarshal(upgradedClient), c.cdc.MustMarshal(upgradedConsState), []byte{1}, []byte{1})
+	require.ErrorIs(t, err, clienttypes.ErrInvalidUpgradeClient)
+	require.Equal(t, uint64(testTrustedHeight), c.clientState(t).LatestHeight)
+}
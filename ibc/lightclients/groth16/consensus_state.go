@@ -3,6 +3,8 @@ package groth16
 import (
 	"time"
 
+	sdkerrors "cosmossdk.io/errors"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -11,10 +13,11 @@ import (
 var _ exported.ConsensusState = (*ConsensusState)(nil)
 
 // NewConsensusState returns a new ConsensusState instance.
-func NewConsensusState(timestamp time.Time, stateRoot []byte) *ConsensusState {
+func NewConsensusState(timestamp time.Time, stateRoot []byte, headerHash []byte) *ConsensusState {
 	return &ConsensusState{
 		HeaderTimestamp: timestamppb.New(timestamp),
 		StateRoot:       stateRoot,
+		HeaderHash:      headerHash,
 	}
 }
 
@@ -34,13 +37,22 @@ func (cs *ConsensusState) GetTimestamp() uint64 {
 	return uint64(cs.HeaderTimestamp.AsTime().UnixNano())
 }
 
-// ValidateBasic is a no-op.
+// ValidateBasic defines a basic validation for the groth16 consensus state.
 func (cs *ConsensusState) ValidateBasic() error {
+	if len(cs.StateRoot) != hashLength {
+		return sdkerrors.Wrapf(ErrInvalidStateRoot, "expected state root length %d, got %d", hashLength, len(cs.StateRoot))
+	}
+	if len(cs.HeaderHash) != hashLength {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "expected header hash length %d, got %d", hashLength, len(cs.HeaderHash))
+	}
+	if cs.HeaderTimestamp == nil {
+		return sdkerrors.Wrap(ErrInvalidTimestamp, "header timestamp cannot be nil")
+	}
+	if err := cs.HeaderTimestamp.CheckValid(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTimestamp, err.Error())
+	}
+	if cs.HeaderTimestamp.AsTime().Unix() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidTimestamp, "header timestamp must be a positive Unix time")
+	}
 	return nil
 }
-
-// IsExpired returns true if the provided blockTime is after the unbonding time
-// of this consensus state's header timestamp.
-func (cs *ConsensusState) IsExpired(blockTime time.Time) bool {
-	return cs.HeaderTimestamp.AsTime().Add(unbondingTime).After(blockTime)
-}
@@ -0,0 +1,73 @@
+package groth16
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestConsensusStateValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(consensusState *ConsensusState)
+		expErr   error
+	}{
+		{
+			"success",
+			func(*ConsensusState) {},
+			nil,
+		},
+		{
+			"state root of wrong length",
+			func(consensusState *ConsensusState) {
+				consensusState.StateRoot = consensusState.StateRoot[1:]
+			},
+			ErrInvalidStateRoot,
+		},
+		{
+			"header hash of wrong length",
+			func(consensusState *ConsensusState) {
+				consensusState.HeaderHash = nil
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+		{
+			"nil timestamp",
+			func(consensusState *ConsensusState) {
+				consensusState.HeaderTimestamp = nil
+			},
+			ErrInvalidTimestamp,
+		},
+		{
+			"out of range timestamp",
+			func(consensusState *ConsensusState) {
+				consensusState.HeaderTimestamp = &timestamppb.Timestamp{Seconds: 1, Nanos: -1}
+			},
+			ErrInvalidTimestamp,
+		},
+		{
+			"zero timestamp",
+			func(consensusState *ConsensusState) {
+				consensusState.HeaderTimestamp = timestamppb.New(time.Unix(0, 0))
+			},
+			ErrInvalidTimestamp,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			consensusState := NewConsensusState(testGenesisTime, testStateRoot(testTrustedHeight), testHeaderHash(testTrustedHeight))
+			tc.malleate(consensusState)
+
+			err := consensusState.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
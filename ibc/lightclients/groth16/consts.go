@@ -3,8 +3,16 @@ package groth16
 import "time"
 
 const (
-	day           = 24 * time.Hour
-	week          = 7 * day
-	unbondingTime = 3 * week
-	ModuleName    = "08-groth16"
+	day  = 24 * time.Hour
+	week = 7 * day
+	// DefaultTrustingPeriod is the default trusting period for new groth16 clients.
+	DefaultTrustingPeriod = 2 * week
+	// DefaultMaxClockDrift is the default max clock drift for new groth16 clients.
+	DefaultMaxClockDrift = 10 * time.Second
+	// MaxPrunedConsensusStatesPerUpdate is the maximum number of expired consensus states
+	// that are pruned from the client store on each client update.
+	MaxPrunedConsensusStatesPerUpdate = 10
+	ModuleName                        = "08-groth16"
+	// TransientStoreKey is the key of the transient store recording the proofs verified within a transaction.
+	TransientStoreKey = "transient_" + ModuleName
 )
@@ -23,4 +23,12 @@ var (
 	ErrUnbondingPeriodExpired  = sdkerrors.Register(SubModuleName, 12, "time since latest trusted state has passed the unbonding period")
 	ErrInvalidProofSpecs       = sdkerrors.Register(SubModuleName, 13, "invalid proof specs")
 	ErrInvalidValidatorSet     = sdkerrors.Register(SubModuleName, 14, "invalid validator set")
+
+	ErrInvalidStateTransitionProof = sdkerrors.Register(SubModuleName, 15, "invalid state transition proof")
+	ErrInvalidVerifierKey          = sdkerrors.Register(SubModuleName, 16, "invalid verifier key")
+	ErrInvalidRouterAddress        = sdkerrors.Register(SubModuleName, 17, "invalid ICS26Router address")
+	ErrInvalidStateRoot            = sdkerrors.Register(SubModuleName, 18, "invalid state root")
+	ErrInvalidTimestamp            = sdkerrors.Register(SubModuleName, 19, "invalid timestamp")
+	ErrInvalidLatestHeight         = sdkerrors.Register(SubModuleName, 20, "invalid latest height")
+	ErrInvalidProofSystem          = sdkerrors.Register(SubModuleName, 21, "invalid proof system")
 )
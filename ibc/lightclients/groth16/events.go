@@ -0,0 +1,45 @@
+package groth16
+
+import (
+	"cosmossdk.io/log"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// moduleLogger returns the context logger of the groth16 light client module.
+func moduleLogger(ctx sdktypes.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ModuleName)
+}
+
+// emitCreateClientEvent emits a create client event for the groth16 client.
+func emitCreateClientEvent(ctx sdktypes.Context, clientID string, height exported.Height, consensusState *ConsensusState) error {
+	return ctx.EventManager().EmitTypedEvent(&EventCreateClient{
+		ClientId:  clientID,
+		Height:    newHeight(height),
+		StateRoot: consensusState.StateRoot,
+	})
+}
+
+// emitUpdateClientEvent emits an update client event for the groth16 client.
+func emitUpdateClientEvent(ctx sdktypes.Context, clientID string, oldHeight, updatedHeight exported.Height, header *Header) error {
+	return ctx.EventManager().EmitTypedEvent(&EventUpdateClient{
+		ClientId:  clientID,
+		OldHeight: newHeight(oldHeight),
+		NewHeight: newHeight(updatedHeight),
+		StateRoot: header.NewestStateRoot,
+		ProofSize: uint64(len(header.StateTransitionProof)),
+	})
+}
+
+// emitPruneConsensusStatesEvent emits a prune consensus states event for the groth16 client.
+func emitPruneConsensusStatesEvent(ctx sdktypes.Context, clientID string, prunedHeights []exported.Height) error {
+	heights := make([]*Height, len(prunedHeights))
+	for i, height := range prunedHeights {
+		heights[i] = newHeight(height)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&EventPruneConsensusStates{
+		ClientId:      clientID,
+		PrunedHeights: heights,
+	})
+}
@@ -0,0 +1,377 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: ibc/lightclients/groth16/v1/events.proto
+
+package groth16
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventCreateClient is emitted when a groth16 client is created.
+type EventCreateClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Height is the latest height of the created client.
+	Height *Height `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	// StateRoot is the EVM state root of the initial consensus state.
+	StateRoot []byte `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+}
+
+func (x *EventCreateClient) Reset() {
+	*x = EventCreateClient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreateClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreateClient) ProtoMessage() {}
+
+func (x *EventCreateClient) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventCreateClient.ProtoReflect.Descriptor instead.
+func (*EventCreateClient) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventCreateClient) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *EventCreateClient) GetHeight() *Height {
+	if x != nil {
+		return x.Height
+	}
+	return nil
+}
+
+func (x *EventCreateClient) GetStateRoot() []byte {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
+
+// EventUpdateClient is emitted when a groth16 client stores a new consensus
+// state after verifying a state transition proof.
+type EventUpdateClient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// OldHeight is the latest height of the client before the update.
+	OldHeight *Height `protobuf:"bytes,2,opt,name=old_height,json=oldHeight,proto3" json:"old_height,omitempty"`
+	// NewHeight is the height of the new consensus state.
+	NewHeight *Height `protobuf:"bytes,3,opt,name=new_height,json=newHeight,proto3" json:"new_height,omitempty"`
+	// StateRoot is the EVM state root of the new consensus state.
+	StateRoot []byte `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// ProofSize is the size in bytes of the state transition proof.
+	ProofSize uint64 `protobuf:"varint,5,opt,name=proof_size,json=proofSize,proto3" json:"proof_size,omitempty"`
+}
+
+func (x *EventUpdateClient) Reset() {
+	*x = EventUpdateClient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateClient) ProtoMessage() {}
+
+func (x *EventUpdateClient) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventUpdateClient.ProtoReflect.Descriptor instead.
+func (*EventUpdateClient) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventUpdateClient) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *EventUpdateClient) GetOldHeight() *Height {
+	if x != nil {
+		return x.OldHeight
+	}
+	return nil
+}
+
+func (x *EventUpdateClient) GetNewHeight() *Height {
+	if x != nil {
+		return x.NewHeight
+	}
+	return nil
+}
+
+func (x *EventUpdateClient) GetStateRoot() []byte {
+	if x != nil {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (x *EventUpdateClient) GetProofSize() uint64 {
+	if x != nil {
+		return x.ProofSize
+	}
+	return 0
+}
+
+// EventPruneConsensusStates is emitted when expired consensus states of a
+// groth16 client are pruned.
+type EventPruneConsensusStates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// PrunedHeights are the heights of the pruned consensus states in ascending
+	// order.
+	PrunedHeights []*Height `protobuf:"bytes,2,rep,name=pruned_heights,json=prunedHeights,proto3" json:"pruned_heights,omitempty"`
+}
+
+func (x *EventPruneConsensusStates) Reset() {
+	*x = EventPruneConsensusStates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventPruneConsensusStates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPruneConsensusStates) ProtoMessage() {}
+
+func (x *EventPruneConsensusStates) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPruneConsensusStates.ProtoReflect.Descriptor instead.
+func (*EventPruneConsensusStates) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventPruneConsensusStates) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *EventPruneConsensusStates) GetPrunedHeights() []*Height {
+	if x != nil {
+		return x.PrunedHeights
+	}
+	return nil
+}
+
+var File_ibc_lightclients_groth16_v1_events_proto protoreflect.FileDescriptor
+
+var file_ibc_lightclients_groth16_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x24, 0x63, 0x65, 0x6c, 0x65,
+	0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31,
+	0x1a, 0x29, 0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72,
+	0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x01, 0x0a, 0x11,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x44,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68,
+	0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6f, 0x74, 0x22, 0x88, 0x02, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x65, 0x6c,
+	0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x09, 0x6f, 0x6c, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x4b, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74,
+	0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8d,
+	0x01, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x0e, 0x70, 0x72, 0x75,
+	0x6e, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63,
+	0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x0d, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x42, 0x1a,
+	0x5a, 0x18, 0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_ibc_lightclients_groth16_v1_events_proto_rawDescOnce sync.Once
+	file_ibc_lightclients_groth16_v1_events_proto_rawDescData = file_ibc_lightclients_groth16_v1_events_proto_rawDesc
+)
+
+func file_ibc_lightclients_groth16_v1_events_proto_rawDescGZIP() []byte {
+	file_ibc_lightclients_groth16_v1_events_proto_rawDescOnce.Do(func() {
+		file_ibc_lightclients_groth16_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_ibc_lightclients_groth16_v1_events_proto_rawDescData)
+	})
+	return file_ibc_lightclients_groth16_v1_events_proto_rawDescData
+}
+
+var file_ibc_lightclients_groth16_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ibc_lightclients_groth16_v1_events_proto_goTypes = []any{
+	(*EventCreateClient)(nil),         // 0: celestia.ibc.lightclients.groth16.v1.EventCreateClient
+	(*EventUpdateClient)(nil),         // 1: celestia.ibc.lightclients.groth16.v1.EventUpdateClient
+	(*EventPruneConsensusStates)(nil), // 2: celestia.ibc.lightclients.groth16.v1.EventPruneConsensusStates
+	(*Height)(nil),                    // 3: celestia.ibc.lightclients.groth16.v1.Height
+}
+var file_ibc_lightclients_groth16_v1_events_proto_depIdxs = []int32{
+	3, // 0: celestia.ibc.lightclients.groth16.v1.EventCreateClient.height:type_name -> celestia.ibc.lightclients.groth16.v1.Height
+	3, // 1: celestia.ibc.lightclients.groth16.v1.EventUpdateClient.old_height:type_name -> celestia.ibc.lightclients.groth16.v1.Height
+	3, // 2: celestia.ibc.lightclients.groth16.v1.EventUpdateClient.new_height:type_name -> celestia.ibc.lightclients.groth16.v1.Height
+	3, // 3: celestia.ibc.lightclients.groth16.v1.EventPruneConsensusStates.pruned_heights:type_name -> celestia.ibc.lightclients.groth16.v1.Height
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ibc_lightclients_groth16_v1_events_proto_init() }
+func file_ibc_lightclients_groth16_v1_events_proto_init() {
+	if File_ibc_lightclients_groth16_v1_events_proto != nil {
+		return
+	}
+	file_ibc_lightclients_groth16_v1_groth16_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ibc_lightclients_groth16_v1_events_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*EventCreateClient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_events_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*EventUpdateClient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_events_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*EventPruneConsensusStates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ibc_lightclients_groth16_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ibc_lightclients_groth16_v1_events_proto_goTypes,
+		DependencyIndexes: file_ibc_lightclients_groth16_v1_events_proto_depIdxs,
+		MessageInfos:      file_ibc_lightclients_groth16_v1_events_proto_msgTypes,
+	}.Build()
+	File_ibc_lightclients_groth16_v1_events_proto = out.File
+	file_ibc_lightclients_groth16_v1_events_proto_rawDesc = nil
+	file_ibc_lightclients_groth16_v1_events_proto_goTypes = nil
+	file_ibc_lightclients_groth16_v1_events_proto_depIdxs = nil
+}
@@ -0,0 +1,126 @@
+package groth16
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func TestInitializeEmitsCreateClientEvent(t *testing.T) {
+	c := newTestClient(t)
+
+	events := typedEvents(t, c.ctx)
+	require.Len(t, events, 1)
+	requireEvent(t, &EventCreateClient{
+		ClientId:  testClientID,
+		Height:    &Height{RevisionHeight: testTrustedHeight},
+		StateRoot: testStateRoot(testTrustedHeight),
+	}, events[0])
+}
+
+func TestUpdateStateEmitsUpdateClientEvent(t *testing.T) {
+	c := newTestClient(t)
+	c.ctx = c.ctx.WithEventManager(sdktypes.NewEventManager())
+
+	c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+2, testGenesisTime.Add(time.Minute)))
+
+	events := typedEvents(t, c.ctx)
+	require.Len(t, events, 1)
+	requireEvent(t, &EventUpdateClient{
+		ClientId:  testClientID,
+		OldHeight: &Height{RevisionHeight: testTrustedHeight},
+		NewHeight: &Height{RevisionHeight: testTrustedHeight + 2},
+		StateRoot: testStateRoot(testTrustedHeight + 2),
+	}, events[0])
+
+	// a header filling in a consensus state below the latest height does not change the latest height
+	c.ctx = c.ctx.WithEventManager(sdktypes.NewEventManager())
+	c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+1, testGenesisTime.Add(30*time.Second)))
+
+	events = typedEvents(t, c.ctx)
+	require.Len(t, events, 1)
+	requireEvent(t, &EventUpdateClient{
+		ClientId:  testClientID,
+		OldHeight: &Height{RevisionHeight: testTrustedHeight + 2},
+		NewHeight: &Height{RevisionHeight: testTrustedHeight + 1},
+		StateRoot: testStateRoot(testTrustedHeight + 1),
+	}, events[0])
+
+	// a header matching a stored consensus state is a no-op and emits no events
+	c.ctx = c.ctx.WithEventManager(sdktypes.NewEventManager())
+	c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+1, testGenesisTime.Add(30*time.Second)))
+	require.Empty(t, c.ctx.EventManager().Events())
+}
+
+func TestUpdateStateEmitsPruneConsensusStatesEvent(t *testing.T) {
+	c := newTestClient(t)
+	clientStore := c.clientStore()
+	trustingPeriod := c.clientState(t).TrustingPeriod.AsDuration()
+
+	// consensus states below the initial consensus state that have expired
+	for _, height := range []uint64{testTrustedHeight - 2, testTrustedHeight - 1} {
+		timestamp := c.ctx.BlockTime().Add(-trustingPeriod - time.Hour + time.Duration(height)*time.Minute)
+		consensusState := NewConsensusState(timestamp, testStateRoot(height), testHeaderHash(height))
+		SetConsensusState(clientStore, c.cdc, consensusState, clienttypes.NewHeight(0, height))
+		setConsensusMetadata(c.ctx, clientStore, clienttypes.NewHeight(0, height))
+	}
+
+	c.ctx = c.ctx.WithEventManager(sdktypes.NewEventManager())
+	c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+1, testGenesisTime.Add(time.Minute)))
+
+	events := typedEvents(t, c.ctx)
+	require.Len(t, events, 2)
+	requireEvent(t, &EventPruneConsensusStates{
+		ClientId: testClientID,
+		PrunedHeights: []*Height{
+			{RevisionHeight: testTrustedHeight - 2},
+			{RevisionHeight: testTrustedHeight - 1},
+		},
+	}, events[0])
+	require.IsType(t, &EventUpdateClient{}, events[1])
+
+	// the attributes of typed events are the JSON encoded fields of the event
+	attributes := c.ctx.EventManager().Events()[0].Attributes
+	require.Equal(t, "client_id", attributes[0].Key)
+	require.Equal(t, strconv.Quote(testClientID), attributes[0].Value)
+}
+
+// typedEvents returns the typed events emitted on the context event manager. The events are decoded from
+// their JSON encoded attributes in the same way as sdktypes.ParseTypedEvent, which only resolves gogoproto types.
+func typedEvents(t *testing.T, ctx sdktypes.Context) []proto.Message {
+	t.Helper()
+
+	var events []proto.Message
+	for _, event := range ctx.EventManager().Events() {
+		messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(event.Type))
+		require.NoError(t, err)
+
+		fields := make(map[string]json.RawMessage, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			fields[attribute.Key] = json.RawMessage(attribute.Value)
+		}
+		bz, err := json.Marshal(fields)
+		require.NoError(t, err)
+
+		typedEvent := messageType.New().Interface()
+		require.NoError(t, protojson.Unmarshal(bz, typedEvent))
+		events = append(events, typedEvent)
+	}
+	return events
+}
+
+// requireEvent asserts that the typed event equals the expected event.
+func requireEvent(t *testing.T, expected, actual proto.Message) {
+	t.Helper()
+
+	require.True(t, proto.Equal(expected, actual), "expected %v, got %v", expected, actual)
+}
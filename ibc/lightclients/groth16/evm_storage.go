@@ -0,0 +1,167 @@
+package groth16
+
+import (
+	"bytes"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/mpt"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+)
+
+// IbcStoreStorageSlot is the ERC-7201 namespaced storage slot of the IBCStore struct in the
+// ICS26Router contract: keccak256(abi.encode(uint256(keccak256("ibc.storage.IBCStore")) - 1)) & ~bytes32(uint256(0xff)).
+// The commitments mapping is the first field of the struct and is therefore stored at this slot.
+var IbcStoreStorageSlot = ethcommon.HexToHash("0x1260944489272988d9df285149b5aa1b0f48f2136d6f416159f840a3e0747600")
+
+// CommitmentStorageKey returns the ICS26Router storage slot of the commitment stored under the
+// given IBC path: keccak256(keccak256(path) . IbcStoreStorageSlot).
+func CommitmentStorageKey(path []byte) ethcommon.Hash {
+	return crypto.Keccak256Hash(crypto.Keccak256(path), IbcStoreStorageSlot.Bytes())
+}
+
+// commitmentStorageKey returns CommitmentStorageKey of the path and charges the gas of its two keccak256 hashes.
+func commitmentStorageKey(gasMeter storetypes.GasMeter, path []byte) ethcommon.Hash {
+	consumeKeccakGas(gasMeter, len(path))
+	consumeKeccakGas(gasMeter, 2*ethcommon.HashLength)
+	return CommitmentStorageKey(path)
+}
+
+// commitmentPath returns the IBC path from a merkle path. The leading key path elements are the
+// counterparty merkle prefix, the last element holds the IBC path that the ICS26Router commits to.
+func commitmentPath(path exported.Path) ([]byte, error) {
+	merklePath, ok := path.(commitmenttypesv2.MerklePath)
+	if !ok {
+		return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	}
+
+	if len(merklePath.KeyPath) == 0 {
+		return nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "merkle path cannot be empty")
+	}
+
+	return merklePath.KeyPath[len(merklePath.KeyPath)-1], nil
+}
+
+// verifyAccountProof verifies that the account described by the proof is the ICS26Router
+// contract at the given address and that it exists in the state trie with the given state root.
+func verifyAccountProof(gasMeter storetypes.GasMeter, stateRoot []byte, routerAddress ethcommon.Address, proof *MptProof) error {
+	address := ethcommon.BytesToAddress(proof.Address)
+	if address != routerAddress {
+		return sdkerrors.Wrapf(ErrInvalidRouterAddress, "proof is for account %s, expected ICS26Router %s", address, routerAddress)
+	}
+
+	consumeKeccakGas(gasMeter, ethcommon.AddressLength)
+	consumeProofNodesGas(gasMeter, proof.AccountProof)
+	account, err := mpt.VerifyAccountProof(ethcommon.BytesToHash(stateRoot), address, hexNodes(proof.AccountProof))
+	if err != nil {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "account inclusion verification failed: %s", err)
+	}
+
+	// Verify that the claimed account state is the verified account state
+	if account.Nonce != proof.Nonce ||
+		!bytes.Equal(account.Balance.Bytes(), proof.Balance) ||
+		account.Root != ethcommon.BytesToHash(proof.StorageHash) ||
+		!bytes.Equal(account.CodeHash, proof.CodeHash) {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "claimed account state does not match the verified state of account %s", address)
+	}
+
+	return nil
+}
+
+// verifyStorageWord verifies that the storage slot holds the given 32 byte word in the storage trie with
+// the storage root of the proof. A storage multiproof is only verified once per transaction. The account
+// proof must have been verified beforehand.
+func verifyStorageWord(ctx sdktypes.Context, proofs verifiedProofs, proof *MptProof, storageKey ethcommon.Hash, word ethcommon.Hash) error {
+	verifiedValue := new(uint256.Int)
+	if proof.isMultiProof() {
+		value, err := proofs.storageMultiProofValue(ctx, proof, storageKey)
+		if err != nil {
+			return err
+		}
+		if value != nil {
+			if verifiedValue, err = mpt.DecodeStorageValue(value); err != nil {
+				return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage slot %s: %s", storageKey, err)
+			}
+		}
+	} else {
+		storageProof, err := proof.storageProof(storageKey)
+		if err != nil {
+			return err
+		}
+
+		consumeKeccakGas(ctx.GasMeter(), ethcommon.HashLength)
+		consumeProofNodesGas(ctx.GasMeter(), storageProof.Proof)
+		verifiedValue, err = mpt.VerifyStorageProof(ethcommon.BytesToHash(proof.StorageHash), storageKey, hexNodes(storageProof.Proof))
+		if err != nil {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "inclusion verification failed: %s", err)
+		}
+	}
+
+	if ethcommon.Hash(verifiedValue.Bytes32()) != word {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "verified value: %x does not match the expected value: %x", verifiedValue.Bytes32(), word)
+	}
+
+	return nil
+}
+
+// verifyStorageExclusion verifies that the storage slot is absent from the storage trie with the storage
+// root of the proof. A storage multiproof is only verified once per transaction. The account proof must have
+// been verified beforehand.
+func verifyStorageExclusion(ctx sdktypes.Context, proofs verifiedProofs, proof *MptProof, storageKey ethcommon.Hash) error {
+	if proof.isMultiProof() {
+		value, err := proofs.storageMultiProofValue(ctx, proof, storageKey)
+		if err != nil {
+			return err
+		}
+		if value != nil {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "the value for storage key %s exists: %x", storageKey, value)
+		}
+		return nil
+	}
+
+	storageProof, err := proof.storageProof(storageKey)
+	if err != nil {
+		return err
+	}
+
+	consumeKeccakGas(ctx.GasMeter(), ethcommon.HashLength)
+	consumeProofNodesGas(ctx.GasMeter(), storageProof.Proof)
+	if err := mpt.VerifyExclusionProof(ethcommon.BytesToHash(proof.StorageHash), crypto.Keccak256(storageKey.Bytes()), hexNodes(storageProof.Proof)); err != nil {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "exclusion verification failed: %s", err)
+	}
+
+	return nil
+}
+
+// verifyStorageMultiProof verifies the storage slots of the proof together against the storage root of the
+// proof and returns the trie values of the storage slots, which are nil for absent slots. The gas of
+// hashing all storage slots and of loading all storage nodes is charged before the proof is verified.
+func verifyStorageMultiProof(gasMeter storetypes.GasMeter, proof *MptProof) (map[ethcommon.Hash][]byte, error) {
+	multiProof := &mpt.MultiProof{
+		Keys:  make([]hexutil.Bytes, len(proof.StorageProofs)),
+		Nodes: hexNodes(proof.StorageNodes),
+	}
+	for i, storageProof := range proof.StorageProofs {
+		consumeKeccakGas(gasMeter, ethcommon.HashLength)
+		multiProof.Keys[i] = crypto.Keccak256(storageProof.Key)
+	}
+
+	consumeProofNodesGas(gasMeter, proof.StorageNodes)
+	values, err := mpt.VerifyMultiProof(ethcommon.BytesToHash(proof.StorageHash), multiProof)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage multiproof verification failed: %s", err)
+	}
+
+	slotValues := make(map[ethcommon.Hash][]byte, len(values))
+	for i, storageProof := range proof.StorageProofs {
+		slotValues[ethcommon.BytesToHash(storageProof.Key)] = values[i]
+	}
+	return slotValues, nil
+}
@@ -0,0 +1,65 @@
+package groth16
+
+import (
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Gas costs of the proof verification done by the groth16 light client. They are charged to the gas
+// meter of the SDK context before the work is done so that large proofs run out of gas early. The
+// benchmarks in gas_test.go report the gas charged next to the time taken, relative to the secp256k1
+// signature verification the SDK charges DefaultSigVerifyCostSecp256k1 gas for.
+const (
+	// ProofNodeByteGasCost is the gas charged per byte of a trie node of an MPT proof. It matches the
+	// ReadCostPerByte of the SDK KV store gas config, as trie nodes replace reads of the EVM state.
+	ProofNodeByteGasCost storetypes.Gas = 3
+	// KeccakGasCost is the flat gas charged per keccak256 hash, mirroring the EVM KECCAK256 opcode.
+	// Hashing the largest trie node costs under 3% of a secp256k1 signature verification while being
+	// charged more than 3 signature verifications including the node bytes.
+	KeccakGasCost storetypes.Gas = 30
+	// KeccakWordGasCost is the gas charged per 32 byte word hashed by keccak256.
+	KeccakWordGasCost storetypes.Gas = 6
+	// PairingCheckGasCost is the fixed gas charged per pairing check of a state transition proof. A
+	// Groth16 verification takes about 10 secp256k1 signature verifications, or 10_000 gas, and is
+	// charged 5 times that to leave headroom for validators without optimized pairing arithmetic.
+	PairingCheckGasCost storetypes.Gas = 50_000
+)
+
+// Limits on MPT proofs that are enforced before the trie nodes are decoded.
+const (
+	// MaxMptProofSize is the maximum size in bytes of an encoded MPT proof.
+	MaxMptProofSize = 1 << 20
+	// MaxProofNodes is the maximum number of trie nodes of an account or storage proof. A path in the
+	// trie has at most 64 nibbles, so a valid proof never has more nodes.
+	MaxProofNodes = 64
+	// MaxProofNodeSize is the maximum size in bytes of a trie node. The largest node is a branch node
+	// with 16 child hashes and a value, which is well below this limit.
+	MaxProofNodeSize = 1024
+	// MaxStorageProofs is the maximum number of storage proofs of a batch MPT proof.
+	MaxStorageProofs = 256
+	// MaxStorageNodes is the maximum number of deduplicated storage trie nodes of a batch MPT proof whose
+	// storage slots are proven by a multiproof.
+	MaxStorageNodes = MaxProofNodes * MaxStorageProofs
+)
+
+// consumeKeccakGas charges the gas of hashing the given number of bytes with keccak256.
+func consumeKeccakGas(gasMeter storetypes.GasMeter, length int) {
+	words := (storetypes.Gas(length) + 31) / 32
+	gasMeter.ConsumeGas(KeccakGasCost+words*KeccakWordGasCost, "groth16 keccak256")
+}
+
+// consumeProofNodesGas charges the gas of loading the trie nodes of an MPT proof. Every node is charged
+// per byte and nodes of at least 32 bytes are additionally charged for the keccak256 hash they are
+// referenced by.
+func consumeProofNodesGas(gasMeter storetypes.GasMeter, nodes [][]byte) {
+	for _, node := range nodes {
+		gasMeter.ConsumeGas(storetypes.Gas(len(node))*ProofNodeByteGasCost, "groth16 proof node")
+		if len(node) >= 32 {
+			consumeKeccakGas(gasMeter, len(node))
+		}
+	}
+}
+
+// consumePairingGas charges the fixed gas of the pairing checks of a state transition proof.
+func consumePairingGas(gasMeter storetypes.GasMeter, pairingChecks uint64) {
+	gasMeter.ConsumeGas(storetypes.Gas(pairingChecks)*PairingCheckGasCost, "groth16 pairing check")
+}
@@ -0,0 +1,63 @@
+package groth16
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// The benchmarks below report the gas charged for the measured work as gas/op so that the ns/op of
+// the groth16 client costs can be compared to the signature verification that the SDK charges
+// DefaultSigVerifyCostSecp256k1 gas for.
+
+func BenchmarkSecp256k1Verify(b *testing.B) {
+	privKey := secp256k1.GenPrivKey()
+	msg := []byte("groth16 gas calibration")
+	sig, err := privKey.Sign(msg)
+	require.NoError(b, err)
+	pubKey := privKey.PubKey()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !pubKey.VerifySignature(msg, sig) {
+			b.Fatal("invalid signature")
+		}
+	}
+	b.ReportMetric(float64(authtypes.DefaultSigVerifyCostSecp256k1), "gas/op")
+}
+
+func BenchmarkVerifySP1Groth16Proof(b *testing.B) {
+	fixture := newGroth16Fixture(b, testVkeyHash, testPublicValues())
+	verifier := SP1Groth16Verifier{}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := verifier.VerifyStateTransitionProof(fixture.Proof, fixture.PublicValues, fixture.VkeyHash, fixture.Groth16Vk); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(verifier.PairingChecks()*PairingCheckGasCost), "gas/op")
+}
+
+func BenchmarkKeccak256(b *testing.B) {
+	// a hash, an account leaf, a branch node with 16 children and the largest allowed trie node
+	for _, size := range []int{32, 110, 532, MaxProofNodeSize} {
+		b.Run(fmt.Sprintf("%d bytes", size), func(b *testing.B) {
+			data := make([]byte, size)
+			words := uint64(size+31) / 32
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				crypto.Keccak256(data)
+			}
+			b.ReportMetric(float64(KeccakGasCost+words*KeccakWordGasCost+uint64(size)*ProofNodeByteGasCost), "gas/op")
+		})
+	}
+}
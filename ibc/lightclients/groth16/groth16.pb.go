@@ -9,6 +9,7 @@ package groth16
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -21,6 +22,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ProofSystem defines the proof systems that state transition proofs can be
+// verified with.
+type ProofSystem int32
+
+const (
+	// PROOF_SYSTEM_SP1_GROTH16 verifies SP1 Groth16 proofs. It is the default so
+	// that clients created before the proof system was selectable keep verifying
+	// Groth16 proofs.
+	ProofSystem_PROOF_SYSTEM_SP1_GROTH16 ProofSystem = 0
+	// PROOF_SYSTEM_SP1_PLONK is reserved for SP1 PLONK proofs. It is not
+	// supported yet and clients with this proof system are rejected.
+	ProofSystem_PROOF_SYSTEM_SP1_PLONK ProofSystem = 1
+	// PROOF_SYSTEM_MOCK accepts empty mock proofs without verifying them. It
+	// must only be used on devnets running the SP1 mock prover, and clients with
+	// this proof system are rejected unless the chain binary is built with the
+	// groth16mock build tag.
+	ProofSystem_PROOF_SYSTEM_MOCK ProofSystem = 2
+)
+
+// Enum value maps for ProofSystem.
+var (
+	ProofSystem_name = map[int32]string{
+		0: "PROOF_SYSTEM_SP1_GROTH16",
+		1: "PROOF_SYSTEM_SP1_PLONK",
+		2: "PROOF_SYSTEM_MOCK",
+	}
+	ProofSystem_value = map[string]int32{
+		"PROOF_SYSTEM_SP1_GROTH16": 0,
+		"PROOF_SYSTEM_SP1_PLONK":   1,
+		"PROOF_SYSTEM_MOCK":        2,
+	}
+)
+
+func (x ProofSystem) Enum() *ProofSystem {
+	p := new(ProofSystem)
+	*p = x
+	return p
+}
+
+func (x ProofSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProofSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_ibc_lightclients_groth16_v1_groth16_proto_enumTypes[0].Descriptor()
+}
+
+func (ProofSystem) Type() protoreflect.EnumType {
+	return &file_ibc_lightclients_groth16_v1_groth16_proto_enumTypes[0]
+}
+
+func (x ProofSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProofSystem.Descriptor instead.
+func (ProofSystem) EnumDescriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_groth16_proto_rawDescGZIP(), []int{0}
+}
+
 // ClientState defines a groth16 light client that is able to track the state of
 // an EVM roll-up. ClientState contains two holds two keys that are needed to
 // verify IBC packets. One key is for verifying state transition proofs. The
@@ -30,7 +91,9 @@ type ClientState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// LatestHeight is the latest block height on the EVM roll-up.
+	// LatestHeight is the latest block height on the EVM roll-up. It is the
+	// revision height of the latest client height, the revision number is
+	// RevisionNumber.
 	LatestHeight uint64 `protobuf:"varint,1,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty"`
 	// StateTransitionVerifierKey is the verifier key that should be used when
 	// verifying Groth16 state transition proofs. These proofs verify a rollup's
@@ -44,8 +107,32 @@ type ClientState struct {
 	// StateMembershipVerifierKey is the key used to verify state membership
 	// (a.k.a inclusion) proofs.
 	StateMembershipVerifierKey []byte `protobuf:"bytes,5,opt,name=state_membership_verifier_key,json=stateMembershipVerifierKey,proto3" json:"state_membership_verifier_key,omitempty"`
-	// Groth16Vk is the SP1 Groth16 circuit vkey.
+	// Groth16Vk is the gnark serialized SP1 circuit verifying key of the proof
+	// system. It is unused by mock clients.
 	Groth16Vk []byte `protobuf:"bytes,6,opt,name=groth16_vk,json=groth16Vk,proto3" json:"groth16_vk,omitempty"`
+	// FrozenHeight is the EVM height at which misbehaviour was detected. A
+	// non-zero value indicates that the client is frozen.
+	FrozenHeight uint64 `protobuf:"varint,7,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
+	// TrustingPeriod is the duration of the period since the latest consensus
+	// state's header timestamp during which the client can be updated and used
+	// to verify proofs. Once it has passed the client is expired.
+	TrustingPeriod *durationpb.Duration `protobuf:"bytes,8,opt,name=trusting_period,json=trustingPeriod,proto3" json:"trusting_period,omitempty"`
+	// Ics26RouterAddress is the address of the ICS26Router contract on the EVM
+	// roll-up. Membership and non-membership proofs are only accepted for the
+	// storage of this contract.
+	Ics26RouterAddress []byte `protobuf:"bytes,9,opt,name=ics26_router_address,json=ics26RouterAddress,proto3" json:"ics26_router_address,omitempty"`
+	// ChainId is the chain ID of the EVM roll-up.
+	ChainId string `protobuf:"bytes,10,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// ProofSystem is the proof system of the state transition proofs.
+	ProofSystem ProofSystem `protobuf:"varint,11,opt,name=proof_system,json=proofSystem,proto3,enum=celestia.ibc.lightclients.groth16.v1.ProofSystem" json:"proof_system,omitempty"`
+	// MaxClockDrift is the maximum duration that header timestamps may be ahead
+	// of the block time of the chain running the client.
+	MaxClockDrift *durationpb.Duration `protobuf:"bytes,12,opt,name=max_clock_drift,json=maxClockDrift,proto3" json:"max_clock_drift,omitempty"`
+	// RevisionNumber is the revision number of the client heights. EVM chain IDs
+	// do not encode a revision, so it is stored explicitly. A new revision of the
+	// roll-up is tracked by recovering the client with a substitute client at the
+	// new revision.
+	RevisionNumber uint64 `protobuf:"varint,13,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
 }
 
 func (x *ClientState) Reset() {
@@ -122,6 +209,55 @@ func (x *ClientState) GetGroth16Vk() []byte {
 	return nil
 }
 
+func (x *ClientState) GetFrozenHeight() uint64 {
+	if x != nil {
+		return x.FrozenHeight
+	}
+	return 0
+}
+
+func (x *ClientState) GetTrustingPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.TrustingPeriod
+	}
+	return nil
+}
+
+func (x *ClientState) GetIcs26RouterAddress() []byte {
+	if x != nil {
+		return x.Ics26RouterAddress
+	}
+	return nil
+}
+
+func (x *ClientState) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ClientState) GetProofSystem() ProofSystem {
+	if x != nil {
+		return x.ProofSystem
+	}
+	return ProofSystem_PROOF_SYSTEM_SP1_GROTH16
+}
+
+func (x *ClientState) GetMaxClockDrift() *durationpb.Duration {
+	if x != nil {
+		return x.MaxClockDrift
+	}
+	return nil
+}
+
+func (x *ClientState) GetRevisionNumber() uint64 {
+	if x != nil {
+		return x.RevisionNumber
+	}
+	return 0
+}
+
 // ConsensusState is the trusted view of the state of a state machine at a
 // particular Height. It MUST contain sufficient information to enable the
 // ValidityPredicate to validate state updates, which can then be used to
@@ -140,6 +276,10 @@ type ConsensusState struct {
 	HeaderTimestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=header_timestamp,json=headerTimestamp,proto3" json:"header_timestamp,omitempty"`
 	// StateRoot is the state root of the EVM rollup at a particular block height.
 	StateRoot []byte `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// HeaderHash is the hash of the EVM header at a particular block height.
+	// Updates must prove a range of blocks whose first block is a child of this
+	// header.
+	HeaderHash []byte `protobuf:"bytes,3,opt,name=header_hash,json=headerHash,proto3" json:"header_hash,omitempty"`
 }
 
 func (x *ConsensusState) Reset() {
@@ -188,25 +328,33 @@ func (x *ConsensusState) GetStateRoot() []byte {
 	return nil
 }
 
+func (x *ConsensusState) GetHeaderHash() []byte {
+	if x != nil {
+		return x.HeaderHash
+	}
+	return nil
+}
+
 // Header defines a struct that is used to update the consensus state of the groth16 light client.
 type Header struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// StateTransitionProof is a serialized groth16 proof that the given state transition is valid.
-	// If a proof with 0 bytes is provided, it is assumed to be a mock proof.
+	// StateTransitionProof is a serialized SP1 proof that the given state transition is valid.
+	// A proof with 0 bytes is a mock proof that is only accepted by clients using the mock proof system.
 	StateTransitionProof []byte `protobuf:"bytes,1,opt,name=state_transition_proof,json=stateTransitionProof,proto3" json:"state_transition_proof,omitempty"`
 	// PublicValues are bincode serialized public output from the SP1 program that get used as public witness for the verifier.
 	PublicValues []byte `protobuf:"bytes,2,opt,name=public_values,json=publicValues,proto3" json:"public_values,omitempty"`
 	// TrustedHeight is the last verified height of the rollup. This is used to retrieve the previous
 	// state root with which the proof is verified against.
-	TrustedHeight int64 `protobuf:"varint,3,opt,name=trusted_height,json=trustedHeight,proto3" json:"trusted_height,omitempty"`
+	TrustedHeight *Height `protobuf:"bytes,7,opt,name=trusted_height,json=trustedHeight,proto3" json:"trusted_height,omitempty"`
 	// NewestStateRoot is the computed state root of the EVM roll-up after
 	// processing blocks from oldest header hash to newest_header_hash
 	NewestStateRoot []byte `protobuf:"bytes,4,opt,name=newest_state_root,json=newestStateRoot,proto3" json:"newest_state_root,omitempty"`
-	// NewestHeight is the most recent block number of the EVM roll-up
-	NewestHeight uint64 `protobuf:"varint,5,opt,name=newest_height,json=newestHeight,proto3" json:"newest_height,omitempty"`
+	// NewestHeight is the height of the most recent block of the EVM roll-up. It has the same revision
+	// number as the trusted height.
+	NewestHeight *Height `protobuf:"bytes,8,opt,name=newest_height,json=newestHeight,proto3" json:"newest_height,omitempty"`
 	// Timestamp is the timestamp of an EVM header at the new height.
 	Timestamp *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
@@ -257,11 +405,11 @@ func (x *Header) GetPublicValues() []byte {
 	return nil
 }
 
-func (x *Header) GetTrustedHeight() int64 {
+func (x *Header) GetTrustedHeight() *Height {
 	if x != nil {
 		return x.TrustedHeight
 	}
-	return 0
+	return nil
 }
 
 func (x *Header) GetNewestStateRoot() []byte {
@@ -271,11 +419,11 @@ func (x *Header) GetNewestStateRoot() []byte {
 	return nil
 }
 
-func (x *Header) GetNewestHeight() uint64 {
+func (x *Header) GetNewestHeight() *Height {
 	if x != nil {
 		return x.NewestHeight
 	}
-	return 0
+	return nil
 }
 
 func (x *Header) GetTimestamp() *timestamppb.Timestamp {
@@ -285,6 +433,308 @@ func (x *Header) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+// Misbehaviour is a wrapper over two conflicting Headers that were both proven
+// by valid state transition proofs. Misbehaviour is used to freeze the client
+// when the prover attests to two different state roots or EVM header hashes for
+// the same EVM height.
+type Misbehaviour struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header_1 *Header `protobuf:"bytes,1,opt,name=header_1,json=header1,proto3" json:"header_1,omitempty"`
+	Header_2 *Header `protobuf:"bytes,2,opt,name=header_2,json=header2,proto3" json:"header_2,omitempty"`
+}
+
+func (x *Misbehaviour) Reset() {
+	*x = Misbehaviour{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Misbehaviour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Misbehaviour) ProtoMessage() {}
+
+func (x *Misbehaviour) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Misbehaviour.ProtoReflect.Descriptor instead.
+func (*Misbehaviour) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_groth16_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Misbehaviour) GetHeader_1() *Header {
+	if x != nil {
+		return x.Header_1
+	}
+	return nil
+}
+
+func (x *Misbehaviour) GetHeader_2() *Header {
+	if x != nil {
+		return x.Header_2
+	}
+	return nil
+}
+
+// Height is a monotonically increasing data type that mirrors the IBC client
+// height (ibc.core.client.v1.Height). The revision number is the revision of
+// the EVM roll-up and the revision height is its block number.
+type Height struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RevisionNumber uint64 `protobuf:"varint,1,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	RevisionHeight uint64 `protobuf:"varint,2,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
+}
+
+func (x *Height) Reset() {
+	*x = Height{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Height) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Height) ProtoMessage() {}
+
+func (x *Height) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Height.ProtoReflect.Descriptor instead.
+func (*Height) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_groth16_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Height) GetRevisionNumber() uint64 {
+	if x != nil {
+		return x.RevisionNumber
+	}
+	return 0
+}
+
+func (x *Height) GetRevisionHeight() uint64 {
+	if x != nil {
+		return x.RevisionHeight
+	}
+	return 0
+}
+
+// MptProof is the canonical encoding of a Merkle Patricia Trie proof of
+// storage slots of the ICS26Router contract. It mirrors an eth_getProof
+// response: one account proof of the contract and a storage proof for every
+// proven storage slot. Trie nodes are the raw RLP encoded nodes. On chain the
+// proof is prefixed with the MptProofVersionProto version byte.
+// Ref: https://eips.ethereum.org/EIPS/eip-1186
+type MptProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AccountProof are the trie nodes from the state root to the account.
+	AccountProof [][]byte `protobuf:"bytes,1,rep,name=account_proof,json=accountProof,proto3" json:"account_proof,omitempty"`
+	// Address is the 20 byte address of the account.
+	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Balance is the big-endian account balance without leading zeroes.
+	Balance []byte `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	// CodeHash is the 32 byte hash of the account code.
+	CodeHash []byte `protobuf:"bytes,4,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	Nonce    uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// StorageHash is the 32 byte root of the account storage trie.
+	StorageHash   []byte             `protobuf:"bytes,6,opt,name=storage_hash,json=storageHash,proto3" json:"storage_hash,omitempty"`
+	StorageProofs []*MptStorageProof `protobuf:"bytes,7,rep,name=storage_proofs,json=storageProofs,proto3" json:"storage_proofs,omitempty"`
+	// StorageNodes are the deduplicated trie nodes on the paths of all storage
+	// slots. If set, the storage proofs carry no trie nodes and all storage
+	// slots are verified together as a multiproof against the storage hash.
+	StorageNodes [][]byte `protobuf:"bytes,8,rep,name=storage_nodes,json=storageNodes,proto3" json:"storage_nodes,omitempty"`
+}
+
+func (x *MptProof) Reset() {
+	*x = MptProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MptProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MptProof) ProtoMessage() {}
+
+func (x *MptProof) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MptProof.ProtoReflect.Descriptor instead.
+func (*MptProof) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_groth16_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MptProof) GetAccountProof() [][]byte {
+	if x != nil {
+		return x.AccountProof
+	}
+	return nil
+}
+
+func (x *MptProof) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *MptProof) GetBalance() []byte {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *MptProof) GetCodeHash() []byte {
+	if x != nil {
+		return x.CodeHash
+	}
+	return nil
+}
+
+func (x *MptProof) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *MptProof) GetStorageHash() []byte {
+	if x != nil {
+		return x.StorageHash
+	}
+	return nil
+}
+
+func (x *MptProof) GetStorageProofs() []*MptStorageProof {
+	if x != nil {
+		return x.StorageProofs
+	}
+	return nil
+}
+
+func (x *MptProof) GetStorageNodes() [][]byte {
+	if x != nil {
+		return x.StorageNodes
+	}
+	return nil
+}
+
+// MptStorageProof is the proof of a single storage slot of an MptProof.
+type MptStorageProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Key is the 32 byte storage slot.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Value is the big-endian storage value without leading zeroes.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Proof are the trie nodes from the storage root to the storage slot. It is
+	// empty if the trie nodes are part of the storage nodes of the MptProof.
+	Proof [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *MptStorageProof) Reset() {
+	*x = MptStorageProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MptStorageProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MptStorageProof) ProtoMessage() {}
+
+func (x *MptStorageProof) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MptStorageProof.ProtoReflect.Descriptor instead.
+func (*MptStorageProof) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_groth16_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MptStorageProof) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *MptStorageProof) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *MptStorageProof) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
 var File_ibc_lightclients_groth16_v1_groth16_proto protoreflect.FileDescriptor
 
 var file_ibc_lightclients_groth16_v1_groth16_proto_rawDesc = []byte{
@@ -293,9 +743,11 @@ var file_ibc_lightclients_groth16_v1_groth16_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x24, 0x63, 0x65, 0x6c,
 	0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76,
-	0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xae, 0x02, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x6f, 0x22, 0xa6, 0x05, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
 	0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69,
 	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73,
 	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x41, 0x0a, 0x1d, 0x73, 0x74, 0x61, 0x74, 0x65,
@@ -314,34 +766,113 @@ var file_ibc_lightclients_groth16_v1_groth16_proto_rawDesc = []byte{
 	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
 	0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x5f,
 	0x76, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31,
-	0x36, 0x56, 0x6b, 0x22, 0x76, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x95, 0x02, 0x0a, 0x06,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x73, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x23, 0x0a, 0x0d,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74,
-	0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x65,
-	0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x65, 0x77,
-	0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x1a, 0x5a, 0x18, 0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x36, 0x56, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x7a,
+	0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x14,
+	0x69, 0x63, 0x73, 0x32, 0x36, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x69, 0x63, 0x73, 0x32,
+	0x36, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x31, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74,
+	0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12,
+	0x41, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x72, 0x69,
+	0x66, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x69,
+	0x66, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x97, 0x01, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45,
+	0x0a, 0x10, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x48, 0x61, 0x73, 0x68, 0x22, 0xfd, 0x02, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x34, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x14, 0x73, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69,
+	0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6e, 0x65, 0x77,
+	0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x51, 0x0a, 0x0d,
+	0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69,
+	0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a,
+	0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x4d, 0x69, 0x73, 0x62, 0x65, 0x68,
+	0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x12, 0x47, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73,
+	0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x31, 0x12,
+	0x47, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63,
+	0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0x22, 0x5a, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xbc, 0x02, 0x0a, 0x08, 0x4d, 0x70, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63,
+	0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x5c, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73,
+	0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x70, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x0f, 0x4d, 0x70, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x2a, 0x5e, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x53, 0x59, 0x53,
+	0x54, 0x45, 0x4d, 0x5f, 0x53, 0x50, 0x31, 0x5f, 0x47, 0x52, 0x4f, 0x54, 0x48, 0x31, 0x36, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45,
+	0x4d, 0x5f, 0x53, 0x50, 0x31, 0x5f, 0x50, 0x4c, 0x4f, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x4d, 0x4f,
+	0x43, 0x4b, 0x10, 0x02, 0x42, 0x1a, 0x5a, 0x18, 0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -356,21 +887,36 @@ func file_ibc_lightclients_groth16_v1_groth16_proto_rawDescGZIP() []byte {
 	return file_ibc_lightclients_groth16_v1_groth16_proto_rawDescData
 }
 
-var file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ibc_lightclients_groth16_v1_groth16_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_ibc_lightclients_groth16_v1_groth16_proto_goTypes = []any{
-	(*ClientState)(nil),           // 0: celestia.ibc.lightclients.groth16.v1.ClientState
-	(*ConsensusState)(nil),        // 1: celestia.ibc.lightclients.groth16.v1.ConsensusState
-	(*Header)(nil),                // 2: celestia.ibc.lightclients.groth16.v1.Header
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(ProofSystem)(0),              // 0: celestia.ibc.lightclients.groth16.v1.ProofSystem
+	(*ClientState)(nil),           // 1: celestia.ibc.lightclients.groth16.v1.ClientState
+	(*ConsensusState)(nil),        // 2: celestia.ibc.lightclients.groth16.v1.ConsensusState
+	(*Header)(nil),                // 3: celestia.ibc.lightclients.groth16.v1.Header
+	(*Misbehaviour)(nil),          // 4: celestia.ibc.lightclients.groth16.v1.Misbehaviour
+	(*Height)(nil),                // 5: celestia.ibc.lightclients.groth16.v1.Height
+	(*MptProof)(nil),              // 6: celestia.ibc.lightclients.groth16.v1.MptProof
+	(*MptStorageProof)(nil),       // 7: celestia.ibc.lightclients.groth16.v1.MptStorageProof
+	(*durationpb.Duration)(nil),   // 8: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
 }
 var file_ibc_lightclients_groth16_v1_groth16_proto_depIdxs = []int32{
-	3, // 0: celestia.ibc.lightclients.groth16.v1.ConsensusState.header_timestamp:type_name -> google.protobuf.Timestamp
-	3, // 1: celestia.ibc.lightclients.groth16.v1.Header.timestamp:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8,  // 0: celestia.ibc.lightclients.groth16.v1.ClientState.trusting_period:type_name -> google.protobuf.Duration
+	0,  // 1: celestia.ibc.lightclients.groth16.v1.ClientState.proof_system:type_name -> celestia.ibc.lightclients.groth16.v1.ProofSystem
+	8,  // 2: celestia.ibc.lightclients.groth16.v1.ClientState.max_clock_drift:type_name -> google.protobuf.Duration
+	9,  // 3: celestia.ibc.lightclients.groth16.v1.ConsensusState.header_timestamp:type_name -> google.protobuf.Timestamp
+	5,  // 4: celestia.ibc.lightclients.groth16.v1.Header.trusted_height:type_name -> celestia.ibc.lightclients.groth16.v1.Height
+	5,  // 5: celestia.ibc.lightclients.groth16.v1.Header.newest_height:type_name -> celestia.ibc.lightclients.groth16.v1.Height
+	9,  // 6: celestia.ibc.lightclients.groth16.v1.Header.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 7: celestia.ibc.lightclients.groth16.v1.Misbehaviour.header_1:type_name -> celestia.ibc.lightclients.groth16.v1.Header
+	3,  // 8: celestia.ibc.lightclients.groth16.v1.Misbehaviour.header_2:type_name -> celestia.ibc.lightclients.groth16.v1.Header
+	7,  // 9: celestia.ibc.lightclients.groth16.v1.MptProof.storage_proofs:type_name -> celestia.ibc.lightclients.groth16.v1.MptStorageProof
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_ibc_lightclients_groth16_v1_groth16_proto_init() }
@@ -415,19 +961,68 @@ func file_ibc_lightclients_groth16_v1_groth16_proto_init() {
 				return nil
 			}
 		}
+		file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Misbehaviour); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Height); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*MptProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*MptStorageProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ibc_lightclients_groth16_v1_groth16_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_ibc_lightclients_groth16_v1_groth16_proto_goTypes,
 		DependencyIndexes: file_ibc_lightclients_groth16_v1_groth16_proto_depIdxs,
+		EnumInfos:         file_ibc_lightclients_groth16_v1_groth16_proto_enumTypes,
 		MessageInfos:      file_ibc_lightclients_groth16_v1_groth16_proto_msgTypes,
 	}.Build()
 	File_ibc_lightclients_groth16_v1_groth16_proto = out.File
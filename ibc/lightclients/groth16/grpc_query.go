@@ -0,0 +1,232 @@
+package groth16
+
+import (
+	"context"
+	"time"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ QueryServer = (*queryServer)(nil)
+
+// queryServer implements the groth16 light client gRPC query service.
+type queryServer struct {
+	UnimplementedQueryServer
+
+	cdc           codec.BinaryCodec
+	storeProvider clienttypes.StoreProvider
+}
+
+// NewQueryServer returns a new groth16 QueryServer that reads client state from the
+// client stores provided by the store provider.
+func NewQueryServer(cdc codec.BinaryCodec, storeProvider clienttypes.StoreProvider) QueryServer {
+	return &queryServer{
+		cdc:           cdc,
+		storeProvider: storeProvider,
+	}
+}
+
+// ConsensusStates implements the Query/ConsensusStates gRPC method. Consensus states are
+// returned in ascending height order by iterating over their iteration keys.
+func (q *queryServer) ConsensusStates(ctx context.Context, req *QueryConsensusStatesRequest) (*QueryConsensusStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	clientStore, err := q.clientStore(ctx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	var consensusStates []*ConsensusStateWithHeight
+	iterateStore := prefix.NewStore(clientStore, []byte(KeyIterateConsensusStatePrefix))
+	pageRes, err := query.Paginate(iterateStore, toPageRequest(req.Pagination), func(key, value []byte) error {
+		consensusState, found := getTmConsensusState(clientStore, q.cdc, value)
+		if !found {
+			return status.Errorf(codes.Internal, "consensus state not found for iteration key %X", key)
+		}
+
+		consensusStates = append(consensusStates, newConsensusStateWithHeight(getHeightFromBigEndianBytes(key), consensusState))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryConsensusStatesResponse{
+		ConsensusStates: consensusStates,
+		Pagination:      fromPageResponse(pageRes),
+	}, nil
+}
+
+// ConsensusState implements the Query/ConsensusState gRPC method.
+func (q *queryServer) ConsensusState(ctx context.Context, req *QueryConsensusStateRequest) (*QueryConsensusStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	clientStore, err := q.clientStore(ctx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	height := clienttypes.NewHeight(req.RevisionNumber, req.RevisionHeight)
+	consensusState, err := GetConsensusState(clientStore, q.cdc, height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	processedTime, found := GetProcessedTime(clientStore, height)
+	if !found {
+		return nil, status.Error(codes.NotFound, ErrProcessedTimeNotFound.Wrapf("height %s", height).Error())
+	}
+
+	processedHeight, found := GetProcessedHeight(clientStore, height)
+	if !found {
+		return nil, status.Error(codes.NotFound, ErrProcessedHeightNotFound.Wrapf("height %s", height).Error())
+	}
+
+	return &QueryConsensusStateResponse{
+		ConsensusState:  newConsensusStateWithHeight(height, consensusState),
+		ProcessedTime:   timestamppb.New(time.Unix(0, int64(processedTime))),
+		ProcessedHeight: newHeight(processedHeight),
+	}, nil
+}
+
+// VerifierKeys implements the Query/VerifierKeys gRPC method.
+func (q *queryServer) VerifierKeys(ctx context.Context, req *QueryVerifierKeysRequest) (*QueryVerifierKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	clientStore, err := q.clientStore(ctx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	clientState, found := getClientState(clientStore, q.cdc)
+	if !found {
+		return nil, status.Error(codes.NotFound, clienttypes.ErrClientNotFound.Wrap(req.ClientId).Error())
+	}
+
+	return &QueryVerifierKeysResponse{
+		StateTransitionVerifierKey: clientState.StateTransitionVerifierKey,
+		StateMembershipVerifierKey: clientState.StateMembershipVerifierKey,
+		Groth16Vk:                  clientState.Groth16Vk,
+	}, nil
+}
+
+// PreviousConsensusState implements the Query/PreviousConsensusState gRPC method.
+func (q *queryServer) PreviousConsensusState(ctx context.Context, req *QueryPreviousConsensusStateRequest) (*QueryPreviousConsensusStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	clientStore, err := q.clientStore(ctx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	height := clienttypes.NewHeight(req.RevisionNumber, req.RevisionHeight)
+	previousHeight, consensusState, found := getPreviousConsensusStateWithHeight(clientStore, q.cdc, height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no consensus state found below height %s", height)
+	}
+
+	return &QueryPreviousConsensusStateResponse{
+		ConsensusState: newConsensusStateWithHeight(previousHeight, consensusState),
+	}, nil
+}
+
+// NextConsensusState implements the Query/NextConsensusState gRPC method.
+func (q *queryServer) NextConsensusState(ctx context.Context, req *QueryNextConsensusStateRequest) (*QueryNextConsensusStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	clientStore, err := q.clientStore(ctx, req.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	height := clienttypes.NewHeight(req.RevisionNumber, req.RevisionHeight)
+	nextHeight, consensusState, found := getNextConsensusStateWithHeight(clientStore, q.cdc, height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no consensus state found above height %s", height)
+	}
+
+	return &QueryNextConsensusStateResponse{
+		ConsensusState: newConsensusStateWithHeight(nextHeight, consensusState),
+	}, nil
+}
+
+// clientStore validates that the client identifier is a groth16 client identifier and returns its client store.
+func (q *queryServer) clientStore(ctx context.Context, clientID string) (storetypes.KVStore, error) {
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	clientType, _, err := clienttypes.ParseClientIdentifier(clientID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if clientType != Groth16ClientType {
+		return nil, status.Errorf(codes.InvalidArgument, "expected client type %s, got %s", Groth16ClientType, clientType)
+	}
+
+	return q.storeProvider.ClientStore(sdktypes.UnwrapSDKContext(ctx), clientID), nil
+}
+
+// newHeight converts an IBC height into its groth16 proto representation.
+func newHeight(height exported.Height) *Height {
+	return &Height{
+		RevisionNumber: height.GetRevisionNumber(),
+		RevisionHeight: height.GetRevisionHeight(),
+	}
+}
+
+// newConsensusStateWithHeight returns the consensus state paired with the height it is stored at.
+func newConsensusStateWithHeight(height exported.Height, consensusState *ConsensusState) *ConsensusStateWithHeight {
+	return &ConsensusStateWithHeight{
+		Height:         newHeight(height),
+		ConsensusState: consensusState,
+	}
+}
+
+// toPageRequest converts the pagination request of the query service into the SDK pagination request.
+func toPageRequest(pageReq *queryv1beta1.PageRequest) *query.PageRequest {
+	if pageReq == nil {
+		return nil
+	}
+
+	return &query.PageRequest{
+		Key:        pageReq.Key,
+		Offset:     pageReq.Offset,
+		Limit:      pageReq.Limit,
+		CountTotal: pageReq.CountTotal,
+		Reverse:    pageReq.Reverse,
+	}
+}
+
+// fromPageResponse converts the SDK pagination response into the pagination response of the query service.
+func fromPageResponse(pageRes *query.PageResponse) *queryv1beta1.PageResponse {
+	if pageRes == nil {
+		return nil
+	}
+
+	return &queryv1beta1.PageResponse{
+		NextKey: pageRes.NextKey,
+		Total:   pageRes.Total,
+	}
+}
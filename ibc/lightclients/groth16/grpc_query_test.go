@@ -0,0 +1,196 @@
+package groth16
+
+import (
+	"testing"
+	"time"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testQueryHeights are the heights of the consensus states of newTestQueryClient.
+var testQueryHeights = []uint64{testTrustedHeight, testTrustedHeight + 1, testTrustedHeight + 2, testTrustedHeight + 3}
+
+// newTestQueryClient returns a test client with consensus states at testQueryHeights together with a query
+// server reading its store. The consensus state at height h is processed at block height 100+h.
+func newTestQueryClient(t *testing.T) (*testClient, QueryServer) {
+	t.Helper()
+
+	c := newTestClient(t)
+	for i, height := range testQueryHeights[1:] {
+		c.ctx = c.ctx.WithBlockHeight(int64(100 + height)).WithBlockTime(testGenesisTime.Add(time.Hour + time.Duration(i)*time.Minute))
+		c.update(t, newTestHeader(t, height-1, height, testGenesisTime.Add(time.Duration(i+1)*time.Minute)))
+	}
+
+	return c, NewQueryServer(c.cdc, c.module.storeProvider)
+}
+
+func TestQueryConsensusStates(t *testing.T) {
+	c, queryServer := newTestQueryClient(t)
+
+	queryHeights := func(res *QueryConsensusStatesResponse) []uint64 {
+		var heights []uint64
+		for _, consensusState := range res.ConsensusStates {
+			require.Zero(t, consensusState.Height.RevisionNumber)
+			require.Equal(t, c.cdc.MustMarshal(c.consensusState(t, consensusState.Height.RevisionHeight)), c.cdc.MustMarshal(consensusState.ConsensusState))
+			heights = append(heights, consensusState.Height.RevisionHeight)
+		}
+		return heights
+	}
+
+	res, err := queryServer.ConsensusStates(c.ctx, &QueryConsensusStatesRequest{ClientId: testClientID})
+	require.NoError(t, err)
+	require.Equal(t, testQueryHeights, queryHeights(res))
+
+	res, err = queryServer.ConsensusStates(c.ctx, &QueryConsensusStatesRequest{
+		ClientId:   testClientID,
+		Pagination: &queryv1beta1.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, testQueryHeights[:3], queryHeights(res))
+	require.Equal(t, uint64(len(testQueryHeights)), res.Pagination.Total)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	res, err = queryServer.ConsensusStates(c.ctx, &QueryConsensusStatesRequest{
+		ClientId:   testClientID,
+		Pagination: &queryv1beta1.PageRequest{Key: res.Pagination.NextKey, Limit: 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, testQueryHeights[3:], queryHeights(res))
+	require.Empty(t, res.Pagination.NextKey)
+
+	res, err = queryServer.ConsensusStates(c.ctx, &QueryConsensusStatesRequest{
+		ClientId:   testClientID,
+		Pagination: &queryv1beta1.PageRequest{Limit: 2, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{testQueryHeights[3], testQueryHeights[2]}, queryHeights(res))
+}
+
+func TestQueryConsensusState(t *testing.T) {
+	c, queryServer := newTestQueryClient(t)
+
+	for i, height := range testQueryHeights[1:] {
+		res, err := queryServer.ConsensusState(c.ctx, &QueryConsensusStateRequest{ClientId: testClientID, RevisionHeight: height})
+		require.NoError(t, err)
+
+		require.Equal(t, &Height{RevisionHeight: height}, res.ConsensusState.Height)
+		require.Equal(t, c.cdc.MustMarshal(c.consensusState(t, height)), c.cdc.MustMarshal(res.ConsensusState.ConsensusState))
+		require.Equal(t, testGenesisTime.Add(time.Hour+time.Duration(i)*time.Minute), res.ProcessedTime.AsTime())
+		require.Equal(t, &Height{RevisionHeight: 100 + height}, res.ProcessedHeight)
+	}
+
+	_, err := queryServer.ConsensusState(c.ctx, &QueryConsensusStateRequest{ClientId: testClientID, RevisionHeight: testTrustedHeight + 100})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = queryServer.ConsensusState(c.ctx, &QueryConsensusStateRequest{ClientId: testClientID, RevisionNumber: 1, RevisionHeight: testTrustedHeight})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestQueryVerifierKeys(t *testing.T) {
+	c, queryServer := newTestQueryClient(t)
+
+	clientState := c.clientState(t)
+	clientState.StateMembershipVerifierKey = sha256Bytes("membership program")
+	clientState.Groth16Vk = []byte("groth16 vk")
+	setClientState(c.clientStore(), c.cdc, clientState)
+
+	res, err := queryServer.VerifierKeys(c.ctx, &QueryVerifierKeysRequest{ClientId: testClientID})
+	require.NoError(t, err)
+	require.Equal(t, testVkeyHash, res.StateTransitionVerifierKey)
+	require.Equal(t, clientState.StateMembershipVerifierKey, res.StateMembershipVerifierKey)
+	require.Equal(t, clientState.Groth16Vk, res.Groth16Vk)
+}
+
+func TestQueryNeighbouringConsensusStates(t *testing.T) {
+	c, queryServer := newTestQueryClient(t)
+
+	testCases := []struct {
+		name        string
+		height      uint64
+		expPrevious uint64
+		expNext     uint64
+		hasPrevious bool
+		hasNext     bool
+	}{
+		{"below the lowest height", testTrustedHeight - 1, 0, testQueryHeights[0], false, true},
+		{"at the lowest height", testQueryHeights[0], 0, testQueryHeights[1], false, true},
+		{"between the lowest and highest heights", testQueryHeights[2], testQueryHeights[1], testQueryHeights[3], true, true},
+		{"at the highest height", testQueryHeights[3], testQueryHeights[2], 0, true, false},
+		{"above the highest height", testQueryHeights[3] + 100, testQueryHeights[3], 0, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			previous, err := queryServer.PreviousConsensusState(c.ctx, &QueryPreviousConsensusStateRequest{ClientId: testClientID, RevisionHeight: tc.height})
+			if tc.hasPrevious {
+				require.NoError(t, err)
+				require.Equal(t, &Height{RevisionHeight: tc.expPrevious}, previous.ConsensusState.Height)
+				require.Equal(t, c.cdc.MustMarshal(c.consensusState(t, tc.expPrevious)), c.cdc.MustMarshal(previous.ConsensusState.ConsensusState))
+			} else {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			}
+
+			next, err := queryServer.NextConsensusState(c.ctx, &QueryNextConsensusStateRequest{ClientId: testClientID, RevisionHeight: tc.height})
+			if tc.hasNext {
+				require.NoError(t, err)
+				require.Equal(t, &Height{RevisionHeight: tc.expNext}, next.ConsensusState.Height)
+				require.Equal(t, c.cdc.MustMarshal(c.consensusState(t, tc.expNext)), c.cdc.MustMarshal(next.ConsensusState.ConsensusState))
+			} else {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestQueryInvalidClient(t *testing.T) {
+	c, queryServer := newTestQueryClient(t)
+
+	testCases := []struct {
+		name     string
+		clientID string
+		expCode  codes.Code
+	}{
+		{"unknown groth16 client", "08-groth16-100", codes.NotFound},
+		{"not a groth16 client", "07-tendermint-0", codes.InvalidArgument},
+		{"invalid client identifier", "", codes.InvalidArgument},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := queryServer.ConsensusState(c.ctx, &QueryConsensusStateRequest{ClientId: tc.clientID, RevisionHeight: testTrustedHeight})
+			require.Equal(t, tc.expCode, status.Code(err))
+
+			_, err = queryServer.VerifierKeys(c.ctx, &QueryVerifierKeysRequest{ClientId: tc.clientID})
+			require.Equal(t, tc.expCode, status.Code(err))
+
+			_, err = queryServer.PreviousConsensusState(c.ctx, &QueryPreviousConsensusStateRequest{ClientId: tc.clientID, RevisionHeight: testTrustedHeight + 1})
+			require.Equal(t, tc.expCode, status.Code(err))
+
+			_, err = queryServer.NextConsensusState(c.ctx, &QueryNextConsensusStateRequest{ClientId: tc.clientID, RevisionHeight: testTrustedHeight})
+			require.Equal(t, tc.expCode, status.Code(err))
+
+			res, err := queryServer.ConsensusStates(c.ctx, &QueryConsensusStatesRequest{ClientId: tc.clientID})
+			if tc.expCode == codes.NotFound {
+				// an unknown client has no consensus states
+				require.NoError(t, err)
+				require.Empty(t, res.ConsensusStates)
+			} else {
+				require.Equal(t, tc.expCode, status.Code(err))
+			}
+		})
+	}
+
+	_, err := queryServer.ConsensusStates(c.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.ConsensusState(c.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.VerifierKeys(c.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.PreviousConsensusState(c.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.NextConsensusState(c.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -1,12 +1,40 @@
 package groth16
 
 import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ exported.ClientMessage = (*Header)(nil)
 
+// NewHeader returns a header for the state transition proven by the state transition proof. The trusted
+// height, newest height and newest state root are read from the public values of the proof. Both heights
+// have the given revision number.
+func NewHeader(stateTransitionProof []byte, publicValues []byte, revisionNumber uint64, timestamp time.Time) (*Header, error) {
+	output, err := DecodePublicValues(publicValues)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidHeader, "failed to decode public values: %v", err)
+	}
+
+	numBlocks := uint64(len(output.CelestiaHeaderHashes))
+	if numBlocks > output.NewestHeight {
+		return nil, sdkerrors.Wrapf(ErrInvalidHeaderHeight, "proven range of %d blocks exceeds newest height %d", numBlocks, output.NewestHeight)
+	}
+
+	return &Header{
+		StateTransitionProof: stateTransitionProof,
+		PublicValues:         publicValues,
+		TrustedHeight:        &Height{RevisionNumber: revisionNumber, RevisionHeight: output.NewestHeight - numBlocks},
+		NewestStateRoot:      output.NewestStateRoot[:],
+		NewestHeight:         &Height{RevisionNumber: revisionNumber, RevisionHeight: output.NewestHeight},
+		Timestamp:            timestamppb.New(timestamp),
+	}, nil
+}
+
 // ClientType returns the Groth16 client type.
 func (h *Header) ClientType() string {
 	return Groth16ClientType
@@ -14,10 +42,51 @@ func (h *Header) ClientType() string {
 
 // GetHeight returns the EVM height of this header.
 func (h *Header) GetHeight() exported.Height {
-	return clienttypes.NewHeight(0, uint64(h.NewestHeight))
+	return h.NewestHeight.clientHeight()
 }
 
-// ValidateBasic is a no-op.
+// ValidateBasic performs basic validation of the header fields. It does not verify the state
+// transition proof.
 func (h *Header) ValidateBasic() error {
+	if h.Timestamp == nil {
+		return sdkerrors.Wrap(ErrInvalidTimestamp, "header timestamp cannot be nil")
+	}
+	if err := h.Timestamp.CheckValid(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTimestamp, err.Error())
+	}
+	if len(h.NewestStateRoot) != hashLength {
+		return sdkerrors.Wrapf(ErrInvalidStateRoot, "expected newest state root length %d, got %d", hashLength, len(h.NewestStateRoot))
+	}
+	if h.TrustedHeight == nil || h.NewestHeight == nil {
+		return sdkerrors.Wrap(ErrInvalidHeaderHeight, "trusted height and newest height cannot be nil")
+	}
+	// a state transition proof covers a range of blocks of a single revision of the roll-up
+	if h.TrustedHeight.RevisionNumber != h.NewestHeight.RevisionNumber {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeaderHeight,
+			"trusted height revision %d does not match newest height revision %d", h.TrustedHeight.RevisionNumber, h.NewestHeight.RevisionNumber,
+		)
+	}
+	if h.TrustedHeight.RevisionHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeaderHeight, "trusted height must be positive")
+	}
+	if h.TrustedHeight.RevisionHeight >= h.NewestHeight.RevisionHeight {
+		return sdkerrors.Wrapf(ErrInvalidHeaderHeight, "trusted height %s must be less than newest height %s", h.TrustedHeight.clientHeight(), h.GetHeight())
+	}
 	return nil
 }
+
+// newestHeaderHash returns the hash of the EVM header at the newest height proven by the public values.
+func (h *Header) newestHeaderHash() ([]byte, error) {
+	output, err := DecodePublicValues(h.PublicValues)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidHeader, "failed to decode public values: %v", err)
+	}
+
+	return output.NewestHeaderHash[:], nil
+}
+
+// clientHeight converts the groth16 proto height into an IBC height.
+func (h *Height) clientHeight() clienttypes.Height {
+	return clienttypes.NewHeight(h.GetRevisionNumber(), h.GetRevisionHeight())
+}
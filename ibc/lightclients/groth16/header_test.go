@@ -0,0 +1,93 @@
+package groth16
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHeaderValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(header *Header)
+		expErr   error
+	}{
+		{
+			"success",
+			func(*Header) {},
+			nil,
+		},
+		{
+			"nil timestamp",
+			func(header *Header) {
+				header.Timestamp = nil
+			},
+			ErrInvalidTimestamp,
+		},
+		{
+			"out of range timestamp",
+			func(header *Header) {
+				header.Timestamp = &timestamppb.Timestamp{Seconds: 1, Nanos: -1}
+			},
+			ErrInvalidTimestamp,
+		},
+		{
+			"newest state root of wrong length",
+			func(header *Header) {
+				header.NewestStateRoot = header.NewestStateRoot[1:]
+			},
+			ErrInvalidStateRoot,
+		},
+		{
+			"nil trusted height",
+			func(header *Header) {
+				header.TrustedHeight = nil
+			},
+			ErrInvalidHeaderHeight,
+		},
+		{
+			"nil newest height",
+			func(header *Header) {
+				header.NewestHeight = nil
+			},
+			ErrInvalidHeaderHeight,
+		},
+		{
+			"trusted and newest height of different revisions",
+			func(header *Header) {
+				header.NewestHeight.RevisionNumber = header.TrustedHeight.RevisionNumber + 1
+			},
+			ErrInvalidHeaderHeight,
+		},
+		{
+			"zero trusted height",
+			func(header *Header) {
+				header.TrustedHeight.RevisionHeight = 0
+			},
+			ErrInvalidHeaderHeight,
+		},
+		{
+			"trusted height equal to newest height",
+			func(header *Header) {
+				header.TrustedHeight.RevisionHeight = header.NewestHeight.RevisionHeight
+			},
+			ErrInvalidHeaderHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header := newTestHeader(t, testTrustedHeight, testTrustedHeight+1, testGenesisTime.Add(time.Minute))
+			tc.malleate(header)
+
+			err := header.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package groth16
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID = "08-groth16-0"
+	testChainID  = "80087"
+	// testTrustedHeight is the height of the initial consensus state of test clients.
+	testTrustedHeight = 10
+)
+
+var (
+	testRouterAddress = common.HexToAddress("0xe53275a1fca119e1c5eeb32e7a72e54835a63936")
+	// testGenesisTime is the timestamp of the initial consensus state of test clients.
+	testGenesisTime = time.Unix(1735689600, 0).UTC()
+)
+
+// testClient is a groth16 client using the mock proof system together with the context and codec it
+// runs in.
+type testClient struct {
+	ctx    sdktypes.Context
+	cdc    codec.Codec
+	module LightClientModule
+}
+
+// newTestClient creates a mock proof system client whose initial consensus state is the test block at
+// testTrustedHeight. The block time of the context is an hour after the initial consensus state.
+func newTestClient(t *testing.T) *testClient {
+	t.Helper()
+
+	setMockProofSystem(t, true)
+	key := storetypes.NewKVStoreKey(exported.StoreKey)
+	tkey := storetypes.NewTransientStoreKey(TransientStoreKey)
+	ctx := testutil.DefaultContext(key, tkey)
+	ctx = ctx.WithBlockTime(testGenesisTime.Add(time.Hour)).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	c := &testClient{
+		ctx:    ctx,
+		cdc:    cdc,
+		module: NewLightClientModule(cdc, clienttypes.NewStoreProvider(runtime.NewKVStoreService(key)), tkey),
+	}
+
+	clientState := newTestClientState()
+	consensusState := NewConsensusState(testGenesisTime, testStateRoot(testTrustedHeight), testHeaderHash(testTrustedHeight))
+	require.NoError(t, c.module.Initialize(ctx, testClientID, cdc.MustMarshal(clientState), cdc.MustMarshal(consensusState)))
+
+	return c
+}
+
+// newTestClientState returns the mock proof system client state of test clients.
+func newTestClientState() *ClientState {
+	return NewClientState(
+		testChainID,
+		clienttypes.NewHeight(0, testTrustedHeight),
+		ProofSystem_PROOF_SYSTEM_MOCK,
+		testVkeyHash,
+		nil,
+		nil,
+		nil,
+		testStateRoot(0),
+		DefaultTrustingPeriod,
+		DefaultMaxClockDrift,
+		testRouterAddress,
+	)
+}
+
+// setMockProofSystem registers or unregisters the mock verifier of PROOF_SYSTEM_MOCK for the duration
+// of the test, as building with the groth16mock build tag does.
+func setMockProofSystem(t *testing.T, enabled bool) {
+	t.Helper()
+
+	verifier, registered := stateTransitionVerifiers[ProofSystem_PROOF_SYSTEM_MOCK]
+	t.Cleanup(func() {
+		if registered {
+			stateTransitionVerifiers[ProofSystem_PROOF_SYSTEM_MOCK] = verifier
+		} else {
+			delete(stateTransitionVerifiers, ProofSystem_PROOF_SYSTEM_MOCK)
+		}
+	})
+
+	if enabled {
+		stateTransitionVerifiers[ProofSystem_PROOF_SYSTEM_MOCK] = MockVerifier{}
+	} else {
+		delete(stateTransitionVerifiers, ProofSystem_PROOF_SYSTEM_MOCK)
+	}
+}
+
+// clientStore returns the client store of the test client.
+func (c *testClient) clientStore() storetypes.KVStore {
+	return c.module.storeProvider.ClientStore(c.ctx, testClientID)
+}
+
+// clientState returns the stored client state.
+func (c *testClient) clientState(t *testing.T) *ClientState {
+	t.Helper()
+
+	clientState, found := getClientState(c.module.storeProvider.ClientStore(c.ctx, testClientID), c.cdc)
+	require.True(t, found)
+	return clientState
+}
+
+// consensusState returns the stored consensus state at the revision height.
+func (c *testClient) consensusState(t *testing.T, height uint64) *ConsensusState {
+	t.Helper()
+
+	consensusState, err := GetConsensusState(c.module.storeProvider.ClientStore(c.ctx, testClientID), c.cdc, clienttypes.NewHeight(0, height))
+	require.NoError(t, err)
+	return consensusState
+}
+
+// setStateRoot replaces the state root of the initial consensus state.
+func (c *testClient) setStateRoot(t *testing.T, stateRoot common.Hash) {
+	t.Helper()
+
+	consensusState := c.consensusState(t, testTrustedHeight)
+	consensusState.StateRoot = stateRoot.Bytes()
+	SetConsensusState(c.module.storeProvider.ClientStore(c.ctx, testClientID), c.cdc, consensusState, clienttypes.NewHeight(0, testTrustedHeight))
+}
+
+// update verifies the header and updates the client with it.
+func (c *testClient) update(t *testing.T, header *Header) {
+	t.Helper()
+
+	require.NoError(t, c.module.VerifyClientMessage(c.ctx, testClientID, header))
+	require.False(t, c.module.CheckForMisbehaviour(c.ctx, testClientID, header))
+	c.module.UpdateState(c.ctx, testClientID, header)
+}
+
+// testHeaderHash returns the header hash of the test block at the height.
+func testHeaderHash(height uint64) []byte {
+	return sha256Bytes(fmt.Sprintf("header hash %d", height))
+}
+
+// testStateRoot returns the state root of the test block at the height.
+func testStateRoot(height uint64) []byte {
+	return sha256Bytes(fmt.Sprintf("state root %d", height))
+}
+
+// newTestOutput returns the aggregator output proving the test blocks after the trusted height up to
+// the newest height.
+func newTestOutput(trustedHeight, newestHeight uint64) *BlevmAggOutput {
+	output := &BlevmAggOutput{
+		NewestHeight: newestHeight,
+	}
+	copy(output.NewestHeaderHash[:], testHeaderHash(newestHeight))
+	copy(output.OldestHeaderHash[:], testHeaderHash(trustedHeight+1))
+	copy(output.TrustedHeaderHash[:], testHeaderHash(trustedHeight))
+	copy(output.NewestStateRoot[:], testStateRoot(newestHeight))
+	for height := trustedHeight + 1; height <= newestHeight; height++ {
+		output.CelestiaHeaderHashes = append(output.CelestiaHeaderHashes, sha256Bytes(fmt.Sprintf("celestia header hash %d", height)))
+	}
+	return output
+}
+
+// newTestHeader returns a mock proof header of the test blocks after the trusted height up to the
+// newest height.
+func newTestHeader(t *testing.T, trustedHeight, newestHeight uint64, timestamp time.Time) *Header {
+	t.Helper()
+
+	return newTestHeaderFromOutput(t, newTestOutput(trustedHeight, newestHeight), timestamp)
+}
+
+// newTestHeaderFromOutput returns a mock proof header of the aggregator output.
+func newTestHeaderFromOutput(t *testing.T, output *BlevmAggOutput, timestamp time.Time) *Header {
+	t.Helper()
+
+	header, err := NewHeader(nil, encodePublicValues(output), 0, timestamp)
+	require.NoError(t, err)
+	return header
+}
+
+// encodePublicValues returns the bincode encoding of the aggregator output.
+func encodePublicValues(output *BlevmAggOutput) []byte {
+	var publicValues []byte
+	publicValues = append(publicValues, output.NewestHeaderHash[:]...)
+	publicValues = append(publicValues, output.OldestHeaderHash[:]...)
+	publicValues = append(publicValues, output.TrustedHeaderHash[:]...)
+	publicValues = binary.LittleEndian.AppendUint64(publicValues, uint64(len(output.CelestiaHeaderHashes)))
+	for _, hash := range output.CelestiaHeaderHashes {
+		publicValues = append(publicValues, hash...)
+	}
+	publicValues = append(publicValues, output.NewestStateRoot[:]...)
+	return binary.LittleEndian.AppendUint64(publicValues, output.NewestHeight)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -17,14 +18,22 @@ var _ exported.LightClientModule = (*LightClientModule)(nil)
 type LightClientModule struct {
 	cdc           codec.BinaryCodec
 	storeProvider clienttypes.StoreProvider
+	// proofs records the ICS26Router account proofs and storage multiproofs verified within the current
+	// transaction.
+	proofs verifiedProofs
 }
 
-// NewLightClientModule returns a new groth16 LightClientModule.
-func NewLightClientModule(cdc codec.BinaryCodec, storeProvider clienttypes.StoreProvider) LightClientModule {
-	return LightClientModule{
+// NewLightClientModule returns a new groth16 LightClientModule. The transient store key is used to verify
+// the proofs shared by the messages of a transaction only once. If it is nil, every proof is verified.
+func NewLightClientModule(cdc codec.BinaryCodec, storeProvider clienttypes.StoreProvider, transientStoreKey *storetypes.TransientStoreKey) LightClientModule {
+	l := LightClientModule{
 		cdc:           cdc,
 		storeProvider: storeProvider,
 	}
+	if transientStoreKey != nil {
+		l.proofs.storeKey = transientStoreKey
+	}
+	return l
 }
 
 // Initialize unmarshals the provided client and consensus states and performs basic validation. It calls into the
@@ -49,7 +58,12 @@ func (l LightClientModule) Initialize(ctx sdktypes.Context, clientID string, cli
 	}
 
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
-	return clientState.initialize(ctx, l.cdc, clientStore, &consensusState)
+	if err := clientState.initialize(ctx, l.cdc, clientStore, &consensusState); err != nil {
+		return err
+	}
+
+	moduleLogger(ctx).Info("created groth16 client", "client-id", clientID, "height", clientState.GetLatestClientHeight())
+	return emitCreateClientEvent(ctx, clientID, clientState.GetLatestClientHeight(), &consensusState)
 }
 
 // VerifyClientMessage obtains the client state associated with the client
@@ -64,13 +78,28 @@ func (l LightClientModule) VerifyClientMessage(ctx sdktypes.Context, clientID st
 	return clientState.VerifyClientMessage(ctx, l.cdc, clientStore, clientMsg)
 }
 
-// CheckForMisbehaviour is a no-op.
+// CheckForMisbehaviour obtains the client state associated with the client identifier
+// and calls into the clientState.CheckForMisbehaviour method.
 func (l LightClientModule) CheckForMisbehaviour(ctx sdktypes.Context, clientID string, clientMsg exported.ClientMessage) bool {
-	return false
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	return clientState.CheckForMisbehaviour(ctx, l.cdc, clientStore, clientMsg)
 }
 
-// UpdateStateOnMisbehaviour is a no-op.
+// UpdateStateOnMisbehaviour obtains the client state associated with the client identifier
+// and calls into the clientState.UpdateStateOnMisbehaviour method.
 func (l LightClientModule) UpdateStateOnMisbehaviour(ctx sdktypes.Context, clientID string, clientMsg exported.ClientMessage) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
+	}
+
+	clientState.UpdateStateOnMisbehaviour(ctx, l.cdc, clientStore, clientMsg)
 }
 
 // UpdateState obtains the client state associated with the client identifier and calls into the clientState.UpdateConsensusState method.
@@ -81,7 +110,7 @@ func (l LightClientModule) UpdateState(ctx sdktypes.Context, clientID string, cl
 		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
 	}
 
-	height, err := clientState.UpdateState(ctx, l.cdc, clientStore, clientMsg)
+	height, err := clientState.UpdateState(ctx, l.cdc, clientStore, clientID, clientMsg)
 	if err != nil {
 		panic(err)
 	}
@@ -106,7 +135,12 @@ func (l LightClientModule) VerifyMembership(
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
 
-	return clientState.verifyMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+	if err := clientState.verifyMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value, l.proofs); err != nil {
+		moduleLogger(ctx).Debug("membership verification failed", "client-id", clientID, "height", height, "proof-size", len(proof), "error", err)
+		return err
+	}
+
+	return nil
 }
 
 // VerifyNonMembership obtains the client state associated with the client
@@ -126,7 +160,12 @@ func (l LightClientModule) VerifyNonMembership(
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
 	}
 
-	return clientState.verifyNonMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err := clientState.verifyNonMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, l.proofs); err != nil {
+		moduleLogger(ctx).Debug("non-membership verification failed", "client-id", clientID, "height", height, "proof-size", len(proof), "error", err)
+		return err
+	}
+
+	return nil
 }
 
 // Status obtains the client state associated with the client identifier and
@@ -164,7 +203,7 @@ func (l LightClientModule) TimestampAtHeight(ctx sdktypes.Context, clientID stri
 	return clientState.getTimestampAtHeight(clientStore, l.cdc, height)
 }
 
-// RecoverClient asserts that the substitute client is a tendermint client. It obtains the client state associated with the
+// RecoverClient asserts that the substitute client is a groth16 client. It obtains the client state associated with the
 // subject client and calls into the subjectClientState.CheckSubstituteAndUpdateState method.
 func (l LightClientModule) RecoverClient(ctx sdktypes.Context, clientID, substituteClientID string) error {
 	substituteClientType, _, err := clienttypes.ParseClientIdentifier(substituteClientID)
@@ -172,8 +211,8 @@ func (l LightClientModule) RecoverClient(ctx sdktypes.Context, clientID, substit
 		return err
 	}
 
-	if substituteClientType != exported.Tendermint {
-		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", exported.Tendermint, substituteClientType)
+	if substituteClientType != Groth16ClientType {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "expected: %s, got: %s", Groth16ClientType, substituteClientType)
 	}
 
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
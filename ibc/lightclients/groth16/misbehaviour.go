@@ -0,0 +1,48 @@
+package groth16
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*Misbehaviour)(nil)
+
+// NewMisbehaviour creates a new Misbehaviour instance.
+func NewMisbehaviour(header1, header2 *Header) *Misbehaviour {
+	return &Misbehaviour{
+		Header_1: header1,
+		Header_2: header2,
+	}
+}
+
+// ClientType returns the Groth16 client type.
+func (misbehaviour *Misbehaviour) ClientType() string {
+	return Groth16ClientType
+}
+
+// ValidateBasic asserts that both headers are set and that they are for the same height.
+func (misbehaviour *Misbehaviour) ValidateBasic() error {
+	if misbehaviour.Header_1 == nil {
+		return sdkerrors.Wrap(ErrInvalidHeader, "misbehaviour header1 cannot be nil")
+	}
+	if misbehaviour.Header_2 == nil {
+		return sdkerrors.Wrap(ErrInvalidHeader, "misbehaviour header2 cannot be nil")
+	}
+	if err := misbehaviour.Header_1.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "header1 failed validation")
+	}
+	if err := misbehaviour.Header_2.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "header2 failed validation")
+	}
+
+	// only the states proven for the same height can conflict, header timestamps are not proven
+	if !misbehaviour.Header_1.GetHeight().EQ(misbehaviour.Header_2.GetHeight()) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidMisbehaviour,
+			"header1 height does not match header2 height (%s != %s)", misbehaviour.Header_1.GetHeight(), misbehaviour.Header_2.GetHeight(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package groth16
+
+import (
+	"bytes"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// CheckForMisbehaviour detects duplicate height misbehaviour in a submitted Header message and verifies
+// the correctness of a submitted Misbehaviour ClientMessage. Only the fields committed to by the state
+// transition proofs are compared, header timestamps are not proven and cannot establish misbehaviour.
+func (cs *ClientState) CheckForMisbehaviour(ctx sdktypes.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
+	switch msg := msg.(type) {
+	case *Header:
+		return checkHeaderForMisbehaviour(clientStore, cdc, msg)
+	case *Misbehaviour:
+		return isMisbehaviour(msg.Header_1, msg.Header_2)
+	}
+
+	return false
+}
+
+// checkHeaderForMisbehaviour returns true if the header attests to a different state than the consensus
+// state that has already been stored at the same height.
+func checkHeaderForMisbehaviour(clientStore storetypes.KVStore, cdc codec.BinaryCodec, header *Header) bool {
+	// If the stored consensus state does not conflict with the header, the update is a duplicate and
+	// is not misbehaviour.
+	if existingConsState, err := GetConsensusState(clientStore, cdc, header.GetHeight()); err == nil {
+		return !isSameConsensusState(existingConsState, header)
+	}
+
+	return false
+}
+
+// isMisbehaviour returns true if two proven headers for the same height attest to a different state
+// root or a different EVM header hash.
+func isMisbehaviour(header1, header2 *Header) bool {
+	if !header1.GetHeight().EQ(header2.GetHeight()) {
+		return false
+	}
+
+	headerHash1, err := header1.newestHeaderHash()
+	if err != nil {
+		return false
+	}
+	headerHash2, err := header2.newestHeaderHash()
+	if err != nil {
+		return false
+	}
+
+	return !bytes.Equal(header1.NewestStateRoot, header2.NewestStateRoot) || !bytes.Equal(headerHash1, headerHash2)
+}
+
+// isSameConsensusState returns true if the consensus state matches the state root and EVM header hash
+// that the header attests to.
+func isSameConsensusState(consensusState *ConsensusState, header *Header) bool {
+	headerHash, err := header.newestHeaderHash()
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(consensusState.StateRoot, header.NewestStateRoot) && bytes.Equal(consensusState.HeaderHash, headerHash)
+}
+
+// verifyMisbehaviour verifies that both headers of the misbehaviour are valid updates from
+// consensus states trusted by the client, including their state transition proofs.
+func (cs *ClientState) verifyMisbehaviour(ctx sdktypes.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec, misbehaviour *Misbehaviour) error {
+	if err := misbehaviour.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !isMisbehaviour(misbehaviour.Header_1, misbehaviour.Header_2) {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "headers do not conflict")
+	}
+
+	if err := cs.verifyHeader(ctx, clientStore, cdc, misbehaviour.Header_1); err != nil {
+		return sdkerrors.Wrap(err, "verifying header1 in misbehaviour failed")
+	}
+	if err := cs.verifyHeader(ctx, clientStore, cdc, misbehaviour.Header_2); err != nil {
+		return sdkerrors.Wrap(err, "verifying header2 in misbehaviour failed")
+	}
+
+	return nil
+}
+
+// UpdateStateOnMisbehaviour freezes the client by recording the height of the misbehaving
+// header as the frozen height.
+func (cs *ClientState) UpdateStateOnMisbehaviour(ctx sdktypes.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) {
+	var frozenHeight uint64
+	switch msg := clientMsg.(type) {
+	case *Header:
+		frozenHeight = msg.NewestHeight.GetRevisionHeight()
+	case *Misbehaviour:
+		frozenHeight = msg.Header_1.NewestHeight.GetRevisionHeight()
+	}
+
+	// a frozen height of zero would leave the client active
+	if frozenHeight == 0 {
+		frozenHeight = 1
+	}
+
+	cs.FrozenHeight = frozenHeight
+	setClientState(clientStore, cdc, cs)
+}
@@ -0,0 +1,164 @@
+package groth16
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayedHeaderWithTamperedTimestamp(t *testing.T) {
+	c := newTestClient(t)
+
+	timestamp := testGenesisTime.Add(time.Minute)
+	c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp))
+
+	// the proof of the replayed header is valid, only the unproven timestamp is changed
+	replayed := newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp.Add(time.Second))
+	require.ErrorIs(t, c.module.VerifyClientMessage(c.ctx, testClientID, replayed), ErrInvalidHeader)
+	require.False(t, c.module.CheckForMisbehaviour(c.ctx, testClientID, replayed))
+
+	require.Equal(t, exported.Active, c.module.Status(c.ctx, testClientID))
+	require.Equal(t, timestamp, c.consensusState(t, testTrustedHeight+1).HeaderTimestamp.AsTime())
+
+	// a misbehaviour of the original and the replayed header does not freeze the client either
+	original := newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp)
+	misbehaviour := NewMisbehaviour(original, replayed)
+	require.Error(t, c.module.VerifyClientMessage(c.ctx, testClientID, misbehaviour))
+	require.False(t, c.module.CheckForMisbehaviour(c.ctx, testClientID, misbehaviour))
+	require.Equal(t, exported.Active, c.module.Status(c.ctx, testClientID))
+}
+
+func TestCheckForMisbehaviourHeader(t *testing.T) {
+	timestamp := testGenesisTime.Add(time.Minute)
+
+	testCases := []struct {
+		name            string
+		header          func(t *testing.T) *Header
+		expMisbehaviour bool
+	}{
+		{
+			"duplicate header",
+			func(t *testing.T) *Header {
+				return newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp)
+			},
+			false,
+		},
+		{
+			"header for a new height",
+			func(t *testing.T) *Header {
+				return newTestHeader(t, testTrustedHeight, testTrustedHeight+2, timestamp.Add(time.Minute))
+			},
+			false,
+		},
+		{
+			"conflicting state root",
+			func(t *testing.T) *Header {
+				output := newTestOutput(testTrustedHeight, testTrustedHeight+1)
+				copy(output.NewestStateRoot[:], testStateRoot(testTrustedHeight+2))
+				return newTestHeaderFromOutput(t, output, timestamp)
+			},
+			true,
+		},
+		{
+			"conflicting header hash",
+			func(t *testing.T) *Header {
+				output := newTestOutput(testTrustedHeight, testTrustedHeight+1)
+				copy(output.NewestHeaderHash[:], testHeaderHash(testTrustedHeight+2))
+				return newTestHeaderFromOutput(t, output, timestamp)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t)
+			c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp))
+
+			header := tc.header(t)
+			require.NoError(t, c.module.VerifyClientMessage(c.ctx, testClientID, header))
+			require.Equal(t, tc.expMisbehaviour, c.module.CheckForMisbehaviour(c.ctx, testClientID, header))
+
+			if tc.expMisbehaviour {
+				c.module.UpdateStateOnMisbehaviour(c.ctx, testClientID, header)
+				require.Equal(t, exported.Frozen, c.module.Status(c.ctx, testClientID))
+			}
+		})
+	}
+}
+
+func TestVerifyMisbehaviour(t *testing.T) {
+	timestamp := testGenesisTime.Add(time.Minute)
+
+	testCases := []struct {
+		name         string
+		misbehaviour func(t *testing.T) *Misbehaviour
+		expErr       error
+	}{
+		{
+			"conflicting state roots",
+			func(t *testing.T) *Misbehaviour {
+				output := newTestOutput(testTrustedHeight, testTrustedHeight+1)
+				copy(output.NewestStateRoot[:], testStateRoot(testTrustedHeight+2))
+				return NewMisbehaviour(
+					newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp),
+					newTestHeaderFromOutput(t, output, timestamp),
+				)
+			},
+			nil,
+		},
+		{
+			"conflicting header hashes with different timestamps",
+			func(t *testing.T) *Misbehaviour {
+				output := newTestOutput(testTrustedHeight, testTrustedHeight+1)
+				copy(output.NewestHeaderHash[:], testHeaderHash(testTrustedHeight+2))
+				return NewMisbehaviour(
+					newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp),
+					newTestHeaderFromOutput(t, output, timestamp.Add(time.Second)),
+				)
+			},
+			nil,
+		},
+		{
+			"headers only differ in timestamp",
+			func(t *testing.T) *Misbehaviour {
+				return NewMisbehaviour(
+					newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp),
+					newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp.Add(time.Second)),
+				)
+			},
+			clienttypes.ErrInvalidMisbehaviour,
+		},
+		{
+			"headers for different heights",
+			func(t *testing.T) *Misbehaviour {
+				return NewMisbehaviour(
+					newTestHeader(t, testTrustedHeight, testTrustedHeight+2, timestamp),
+					newTestHeader(t, testTrustedHeight, testTrustedHeight+1, timestamp.Add(time.Second)),
+				)
+			},
+			clienttypes.ErrInvalidMisbehaviour,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t)
+
+			misbehaviour := tc.misbehaviour(t)
+			err := c.module.VerifyClientMessage(c.ctx, testClientID, misbehaviour)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, c.module.CheckForMisbehaviour(c.ctx, testClientID, misbehaviour))
+			c.module.UpdateStateOnMisbehaviour(c.ctx, testClientID, misbehaviour)
+			require.Equal(t, exported.Frozen, c.module.Status(c.ctx, testClientID))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package groth16
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var _ StateTransitionVerifier = MockVerifier{}
+
+// MockVerifier accepts the empty proofs produced by the SP1 mock prover so that devnets can run
+// with SP1_PROVER=mock. It does not verify anything and must never be used in production, so it
+// is only registered for PROOF_SYSTEM_MOCK in binaries built with the groth16mock build tag.
+type MockVerifier struct{}
+
+// VerifyStateTransitionProof implements StateTransitionVerifier. Only empty mock proofs are
+// accepted so that real proofs are not silently ignored by a misconfigured client.
+func (MockVerifier) VerifyStateTransitionProof(proof []byte, _ []byte, _ string, _ []byte) error {
+	if len(proof) != 0 {
+		return sdkerrors.Wrapf(ErrInvalidStateTransitionProof, "expected empty mock proof, got %d bytes", len(proof))
+	}
+
+	return nil
+}
+
+// ValidateVerifyingKey implements StateTransitionVerifier. Mock proofs do not use a verifying key.
+func (MockVerifier) ValidateVerifyingKey(_ []byte) error {
+	return nil
+}
+
+// PairingChecks implements StateTransitionVerifier. Mock proofs are not verified.
+func (MockVerifier) PairingChecks() uint64 {
+	return 0
+}
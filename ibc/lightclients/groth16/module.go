@@ -1,6 +1,7 @@
 package groth16
 
 import (
+	"context"
 	"encoding/json"
 
 	"cosmossdk.io/core/appmodule"
@@ -15,6 +16,7 @@ import (
 var (
 	_ module.AppModuleBasic = (*AppModuleBasic)(nil)
 	_ appmodule.AppModule   = (*AppModule)(nil)
+	_ module.HasServices    = (*AppModule)(nil)
 )
 
 // AppModuleBasic defines the basic application module used by the groth16 light client.
@@ -48,27 +50,28 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 	RegisterInterfaces(registry)
 }
 
-// DefaultGenesis is a no-op. Genesis is not supported for the groth16 light client.
+// DefaultGenesis is a no-op. The groth16 client and consensus states, including the consensus
+// metadata, are part of the core IBC client genesis.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return nil
 }
 
-// ValidateGenesis is a no-op. Genesis is not supported for the groth16 light client.
+// ValidateGenesis is a no-op. The groth16 client store is validated by the core IBC client genesis.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
 	return nil
 }
 
-// RegisterGRPCGatewayRoutes is a no-op.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
-
-// GetTxCmd is a no-op.
-func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the groth16 light client query service.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientCtx))
+	if err != nil {
+		panic(err)
+	}
 }
 
-// GetQueryCmd is a no-op.
-func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return nil
+// GetTxCmd returns the root tx command for the groth16 light client.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return NewTxCmd()
 }
 
 // AppModule is the application module for the groth16 client module
@@ -83,3 +86,8 @@ func NewAppModule(lightClientModule LightClientModule) AppModule {
 		lightClientModule: lightClientModule,
 	}
 }
+
+// RegisterServices registers the groth16 light client query service.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	RegisterQueryServer(cfg.QueryServer(), NewQueryServer(am.lightClientModule.cdc, am.lightClientModule.storeProvider))
+}
@@ -0,0 +1,45 @@
+package groth16
+
+import (
+	"testing"
+
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appmodule"
+	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoCLIQueryCommands(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+
+	appOptions := autocli.AppOptions{
+		Modules:               map[string]appmodule.AppModule{ModuleName: AppModule{}},
+		AddressCodec:          addresscodec.NewBech32Codec("cosmos"),
+		ValidatorAddressCodec: addresscodec.NewBech32Codec("cosmosvaloper"),
+		ConsensusAddressCodec: addresscodec.NewBech32Codec("cosmosvalcons"),
+		ClientCtx:             client.Context{}.WithInterfaceRegistry(registry),
+	}
+	rootCmd := &cobra.Command{Use: "simd"}
+	rootCmd.AddCommand(&cobra.Command{Use: "query"})
+	require.NoError(t, appOptions.EnhanceRootCommand(rootCmd))
+
+	queryCmd, _, err := rootCmd.Find([]string{"query", ModuleName})
+	require.NoError(t, err)
+	require.Equal(t, ModuleName, queryCmd.Name())
+
+	for _, use := range []string{
+		"consensus-states",
+		"consensus-state",
+		"verifier-keys",
+		"previous-consensus-state",
+		"next-consensus-state",
+	} {
+		cmd, _, err := queryCmd.Find([]string{use})
+		require.NoError(t, err)
+		require.Equal(t, use, cmd.Name())
+	}
+}
@@ -0,0 +1,109 @@
+package groth16
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+// The MPT fixture proves the packet commitments of sequences 1 to 4 of the IBC v2 client 07-tendermint-0
+// and the absence of the packet commitment of sequence 5.
+//go:generate go run ../../mpt/cmd/mpt-fixture -out testdata/mpt_fixture.json -commitment 0x30372d74656e6465726d696e742d30010000000000000001=0x00000000000000000000000000000000000000000000000000000000000000a1 -commitment 0x30372d74656e6465726d696e742d30010000000000000002=0x00000000000000000000000000000000000000000000000000000000000000a2 -commitment 0x30372d74656e6465726d696e742d30010000000000000003=0x00000000000000000000000000000000000000000000000000000000000000a3 -commitment 0x30372d74656e6465726d696e742d30010000000000000004=0x00000000000000000000000000000000000000000000000000000000000000a4 -absent 0x30372d74656e6465726d696e742d30010000000000000005
+
+// mptFixturePath is the path of the MPT fixture generated by mpt-fixture.
+var mptFixturePath = filepath.Join("testdata", "mpt_fixture.json")
+
+// mptFixture is an EIP-1186 proof of ICS26Router storage slots together with the state root it is
+// verified against and the IBC paths of the proven storage slots.
+type mptFixture struct {
+	StateRoot common.Hash     `json:"stateRoot"`
+	Proof     json.RawMessage `json:"proof"`
+	Paths     []hexutil.Bytes `json:"paths"`
+}
+
+func TestVerifyMembershipMptFixture(t *testing.T) {
+	fixture := loadMptFixture(t)
+
+	var batchProof MptBatchProofJSON
+	require.NoError(t, json.Unmarshal(fixture.Proof, &batchProof))
+	require.Len(t, batchProof.StorageProof, len(fixture.Paths))
+
+	mptProof, err := batchProof.ToProto()
+	require.NoError(t, err)
+	protoProof, err := EncodeMptProof(mptProof)
+	require.NoError(t, err)
+
+	multiProof, err := MergeMptProofs(mptProof)
+	require.NoError(t, err)
+	require.True(t, multiProof.isMultiProof())
+	encodedMultiProof, err := EncodeMptProof(multiProof)
+	require.NoError(t, err)
+
+	encodings := []struct {
+		name  string
+		proof []byte
+	}{
+		{"json", fixture.Proof},
+		{"proto", protoProof},
+		{"multiproof", encodedMultiProof},
+	}
+
+	height := clienttypes.NewHeight(0, testTrustedHeight)
+	for _, encoding := range encodings {
+		t.Run(encoding.name, func(t *testing.T) {
+			c := newTestClient(t)
+			c.setStateRoot(t, fixture.StateRoot)
+
+			var present, absent int
+			for i, path := range fixture.Paths {
+				merklePath := commitmenttypesv2.NewMerklePath(path)
+				value := common.BigToHash(batchProof.StorageProof[i].Value.ToInt())
+				require.Equal(t, CommitmentStorageKey(path), batchProof.StorageProof[i].Key)
+
+				if value == (common.Hash{}) {
+					absent++
+					require.NoError(t, c.module.VerifyNonMembership(c.ctx, testClientID, height, 0, 0, encoding.proof, merklePath), "path %x", path)
+
+					err := c.module.VerifyMembership(c.ctx, testClientID, height, 0, 0, encoding.proof, merklePath, value.Bytes())
+					require.ErrorIs(t, err, commitmenttypes.ErrInvalidProof, "path %x", path)
+					continue
+				}
+
+				present++
+				require.NoError(t, c.module.VerifyMembership(c.ctx, testClientID, height, 0, 0, encoding.proof, merklePath, value.Bytes()), "path %x", path)
+
+				wrongValue := value
+				wrongValue[len(wrongValue)-1] ^= 1
+				err := c.module.VerifyMembership(c.ctx, testClientID, height, 0, 0, encoding.proof, merklePath, wrongValue.Bytes())
+				require.ErrorIs(t, err, commitmenttypes.ErrInvalidProof, "path %x", path)
+
+				err = c.module.VerifyNonMembership(c.ctx, testClientID, height, 0, 0, encoding.proof, merklePath)
+				require.ErrorIs(t, err, commitmenttypes.ErrInvalidProof, "path %x", path)
+			}
+
+			// the fixture covers both membership and non-membership
+			require.Positive(t, present)
+			require.Positive(t, absent)
+		})
+	}
+}
+
+// loadMptFixture reads the MPT fixture from testdata.
+func loadMptFixture(t *testing.T) *mptFixture {
+	t.Helper()
+
+	bz, err := os.ReadFile(mptFixturePath)
+	require.NoError(t, err)
+
+	var fixture mptFixture
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+	return &fixture
+}
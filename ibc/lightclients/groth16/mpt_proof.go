@@ -0,0 +1,542 @@
+package groth16
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/mpt"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// MptProofVersionProto is the version byte of the canonical MptProof encoding. Membership, non-membership
+// and upgrade proofs are encoded as the version byte followed by the deterministic protobuf encoding of an
+// MptProof. Proofs that do not start with a version byte are decoded as the legacy JSON encodings
+// MptProofJSON and MptBatchProofJSON.
+const MptProofVersionProto byte = 0x01
+
+// Field numbers of the repeated fields of MptProof and MptStorageProof that are bounded before a protobuf
+// encoded proof is unmarshalled.
+const (
+	mptProofAccountProofField  protowire.Number = 1
+	mptProofStorageProofsField protowire.Number = 7
+	mptProofStorageNodesField  protowire.Number = 8
+	mptStorageProofProofField  protowire.Number = 3
+)
+
+// maxJSONStringLength is the maximum length of a string of a JSON encoded proof: a 0x prefixed hex
+// encoded trie node of MaxProofNodeSize bytes.
+const maxJSONStringLength = 2 + 2*MaxProofNodeSize
+
+// MptProofJSON is the legacy JSON encoding of a Merkle Patricia Trie proof of a single storage slot of the
+// ICS26Router contract. It includes both account and storage proofs from eth_getProof response.
+// Ref: https://eips.ethereum.org/EIPS/eip-1186
+type MptProofJSON struct {
+	// The account proof is used to verify the account state of the ICS26Router contract.
+	AccountProof []hexutil.Bytes `json:"accountProof"`
+	Address      common.Address  `json:"address"`
+	Balance      *hexutil.Big    `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+
+	// Storage proof for the packet commitment in ICS26Router contract storage.
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []hexutil.Bytes `json:"storageProof"`
+	StorageKey   common.Hash     `json:"storageKey"`
+	StorageValue hexutil.Big     `json:"storageValue"`
+}
+
+// MptBatchProofJSON is the legacy JSON encoding of a Merkle Patricia Trie proof of several storage slots
+// of the ICS26Router contract. It has the shape of an eth_getProof response for multiple storage keys.
+// Ref: https://eips.ethereum.org/EIPS/eip-1186
+type MptBatchProofJSON struct {
+	// The account proof is used to verify the account state of the ICS26Router contract.
+	AccountProof []hexutil.Bytes `json:"accountProof"`
+	Address      common.Address  `json:"address"`
+	Balance      *hexutil.Big    `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+
+	// Storage proofs for storage slots in ICS26Router contract storage.
+	StorageHash  common.Hash        `json:"storageHash"`
+	StorageProof []StorageProofJSON `json:"storageProof"`
+}
+
+// StorageProofJSON is the legacy JSON encoding of the proof of a single storage slot of an MptBatchProofJSON.
+type StorageProofJSON struct {
+	Key   common.Hash     `json:"key"`
+	Value hexutil.Big     `json:"value"`
+	Proof []hexutil.Bytes `json:"proof"`
+}
+
+// ToProto converts the single key JSON proof into an MptProof with one storage proof.
+func (p *MptProofJSON) ToProto() (*MptProof, error) {
+	batchProof := &MptBatchProofJSON{
+		AccountProof: p.AccountProof,
+		Address:      p.Address,
+		Balance:      p.Balance,
+		CodeHash:     p.CodeHash,
+		Nonce:        p.Nonce,
+		StorageHash:  p.StorageHash,
+	}
+	// single key proofs of accounts without storage may omit the storage proof
+	if len(p.StorageProof) != 0 || p.StorageKey != (common.Hash{}) {
+		batchProof.StorageProof = []StorageProofJSON{{
+			Key:   p.StorageKey,
+			Value: p.StorageValue,
+			Proof: p.StorageProof,
+		}}
+	}
+
+	return batchProof.ToProto()
+}
+
+// ToProto converts the JSON batch proof into an MptProof.
+func (p *MptBatchProofJSON) ToProto() (*MptProof, error) {
+	if p.Balance == nil {
+		return nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "account balance cannot be empty")
+	}
+
+	proof := &MptProof{
+		AccountProof:  nodeBytes(p.AccountProof),
+		Address:       p.Address.Bytes(),
+		Balance:       p.Balance.ToInt().Bytes(),
+		CodeHash:      p.CodeHash.Bytes(),
+		Nonce:         uint64(p.Nonce),
+		StorageHash:   p.StorageHash.Bytes(),
+		StorageProofs: make([]*MptStorageProof, len(p.StorageProof)),
+	}
+	for i, storageProof := range p.StorageProof {
+		proof.StorageProofs[i] = &MptStorageProof{
+			Key:   storageProof.Key.Bytes(),
+			Value: storageProof.Value.ToInt().Bytes(),
+			Proof: nodeBytes(storageProof.Proof),
+		}
+	}
+
+	return proof, nil
+}
+
+// MergeMptProofs merges the proofs of storage slots of the ICS26Router contract at the same height, e.g.
+// the proofs of several eth_getProof responses, into a single proof whose storage slots are proven by a
+// multiproof. Trie nodes shared by the storage slots are only included once. All proofs must have the
+// same account proof and storage slots that are part of several proofs must have the same value.
+func MergeMptProofs(proofs ...*MptProof) (*MptProof, error) {
+	if len(proofs) == 0 {
+		return nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "no mpt proofs to merge")
+	}
+
+	account := accountProof(proofs[0])
+	merged := accountProof(proofs[0])
+	builder := mpt.NewMultiProofBuilder()
+	values := make(map[common.Hash][]byte)
+	for i, proof := range proofs {
+		if proof.isMultiProof() {
+			return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "mpt proof %d is already a multiproof", i)
+		}
+		if !proto.Equal(accountProof(proof), account) {
+			return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "mpt proof %d has a different account proof", i)
+		}
+
+		for _, storageProof := range proof.StorageProofs {
+			slot := common.BytesToHash(storageProof.Key)
+			if value, ok := values[slot]; ok {
+				if !bytes.Equal(value, storageProof.Value) {
+					return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage slot %s has different values", slot)
+				}
+				continue
+			}
+			values[slot] = storageProof.Value
+
+			if err := builder.Add(crypto.Keccak256(slot.Bytes()), hexNodes(storageProof.Proof)); err != nil {
+				return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage slot %s: %s", slot, err)
+			}
+			merged.StorageProofs = append(merged.StorageProofs, &MptStorageProof{
+				Key:   slot.Bytes(),
+				Value: storageProof.Value,
+			})
+		}
+	}
+	merged.StorageNodes = nodeBytes(builder.Build().Nodes)
+
+	if err := merged.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+// accountProof returns a copy of the proof without its storage proofs.
+func accountProof(proof *MptProof) *MptProof {
+	return &MptProof{
+		AccountProof: proof.AccountProof,
+		Address:      proof.Address,
+		Balance:      proof.Balance,
+		CodeHash:     proof.CodeHash,
+		Nonce:        proof.Nonce,
+		StorageHash:  proof.StorageHash,
+	}
+}
+
+// EncodeMptProof returns the canonical encoding of the proof: the MptProofVersionProto version byte
+// followed by the deterministic protobuf encoding of the proof.
+func EncodeMptProof(proof *MptProof) ([]byte, error) {
+	if err := proof.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(proof)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mpt proof: %w", err)
+	}
+
+	return append([]byte{MptProofVersionProto}, bz...), nil
+}
+
+// decodeMptProof decodes a proof in the canonical encoding or in one of the legacy JSON encodings. The
+// protobuf encoding must be canonical: it must not contain unknown fields and must re-encode to the
+// same bytes.
+func decodeMptProof(bz []byte) (*MptProof, error) {
+	if len(bz) == 0 {
+		return nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "mpt proof cannot be empty")
+	}
+	if len(bz) > MaxMptProofSize {
+		return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "mpt proof size %d exceeds the maximum of %d bytes", len(bz), MaxMptProofSize)
+	}
+
+	var (
+		proof *MptProof
+		err   error
+	)
+	switch {
+	case bz[0] == MptProofVersionProto:
+		proof, err = decodeMptProofProto(bz[1:])
+	case bytes.HasPrefix(bytes.TrimSpace(bz), []byte("{")):
+		proof, err = decodeMptProofJSON(bz)
+	default:
+		return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "unknown mpt proof version %d", bz[0])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := proof.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// decodeMptProofProto decodes the canonical protobuf encoding of an MptProof.
+func decodeMptProofProto(bz []byte) (*MptProof, error) {
+	if err := scanMptProofProto(bz); err != nil {
+		return nil, err
+	}
+
+	var proof MptProof
+	if err := proto.Unmarshal(bz, &proof); err != nil {
+		return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal mpt proof: %v", err)
+	}
+
+	hasUnknownFields := len(proof.ProtoReflect().GetUnknown()) != 0
+	for _, storageProof := range proof.StorageProofs {
+		hasUnknownFields = hasUnknownFields || len(storageProof.ProtoReflect().GetUnknown()) != 0
+	}
+	if hasUnknownFields {
+		return nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "mpt proof contains unknown fields")
+	}
+
+	canonicalBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(&proof)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mpt proof: %w", err)
+	}
+	if !bytes.Equal(bz, canonicalBz) {
+		return nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "mpt proof is not canonically encoded")
+	}
+
+	return &proof, nil
+}
+
+// decodeMptProofJSON decodes a JSON encoded MptProofJSON or MptBatchProofJSON. The two encodings are told
+// apart by their storage proof: an MptProofJSON holds a list of trie nodes, an MptBatchProofJSON a list of
+// objects.
+func decodeMptProofJSON(bz []byte) (*MptProof, error) {
+	if err := scanMptProofJSON(bz); err != nil {
+		return nil, err
+	}
+
+	var storageProofs struct {
+		StorageProof []json.RawMessage `json:"storageProof"`
+	}
+	if err := json.Unmarshal(bz, &storageProofs); err != nil {
+		return nil, fmt.Errorf("failed to deserialize mpt proof: %w", err)
+	}
+
+	if len(storageProofs.StorageProof) != 0 && bytes.HasPrefix(bytes.TrimSpace(storageProofs.StorageProof[0]), []byte("{")) {
+		var batchProof MptBatchProofJSON
+		if err := json.Unmarshal(bz, &batchProof); err != nil {
+			return nil, fmt.Errorf("failed to deserialize mpt batch proof: %w", err)
+		}
+		return batchProof.ToProto()
+	}
+
+	var proof MptProofJSON
+	if err := json.Unmarshal(bz, &proof); err != nil {
+		return nil, fmt.Errorf("failed to deserialize mpt proof: %w", err)
+	}
+	return proof.ToProto()
+}
+
+// scanMptProofProto checks the number and size of the trie nodes and the number of storage proofs of a
+// protobuf encoded MptProof against the limits of ValidateBasic without unmarshalling it. It runs before
+// the proof is unmarshalled so that a proof within MaxMptProofSize cannot allocate an unbounded number of
+// repeated field entries. Malformed encodings are left to the unmarshalling.
+func scanMptProofProto(bz []byte) error {
+	var accountNodes, storageProofs, storageNodes int
+	return scanProtoBytesFields(bz, func(num protowire.Number, value []byte) error {
+		switch num {
+		case mptProofAccountProofField:
+			accountNodes++
+			if accountNodes > MaxProofNodes {
+				return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "account proof: number of trie nodes exceeds the maximum of %d", MaxProofNodes)
+			}
+			if len(value) > MaxProofNodeSize {
+				return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "account proof: trie node size %d exceeds the maximum of %d bytes", len(value), MaxProofNodeSize)
+			}
+		case mptProofStorageProofsField:
+			storageProofs++
+			if storageProofs > MaxStorageProofs {
+				return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "number of storage proofs exceeds the maximum of %d", MaxStorageProofs)
+			}
+
+			var nodes int
+			return scanProtoBytesFields(value, func(num protowire.Number, value []byte) error {
+				if num != mptStorageProofProofField {
+					return nil
+				}
+				nodes++
+				if nodes > MaxProofNodes {
+					return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage proof %d: number of trie nodes exceeds the maximum of %d", storageProofs-1, MaxProofNodes)
+				}
+				if len(value) > MaxProofNodeSize {
+					return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage proof %d: trie node size %d exceeds the maximum of %d bytes", storageProofs-1, len(value), MaxProofNodeSize)
+				}
+				return nil
+			})
+		case mptProofStorageNodesField:
+			storageNodes++
+			if storageNodes > MaxStorageNodes {
+				return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "number of storage nodes exceeds the maximum of %d", MaxStorageNodes)
+			}
+			if len(value) > MaxProofNodeSize {
+				return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage node size %d exceeds the maximum of %d bytes", len(value), MaxProofNodeSize)
+			}
+		}
+		return nil
+	})
+}
+
+// scanProtoBytesFields calls fn with the field number and value of every length delimited field of the
+// protobuf encoded message. Fields of other wire types are skipped.
+func scanProtoBytesFields(bz []byte, fn func(num protowire.Number, value []byte) error) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal mpt proof: %v", protowire.ParseError(n))
+		}
+		bz = bz[n:]
+
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, bz)
+			if n < 0 {
+				return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal mpt proof: %v", protowire.ParseError(n))
+			}
+			bz = bz[n:]
+			continue
+		}
+
+		value, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal mpt proof: %v", protowire.ParseError(n))
+		}
+		bz = bz[n:]
+
+		if err := fn(num, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// jsonContainer is an object or array of a JSON encoded proof that is being scanned.
+type jsonContainer struct {
+	array    bool
+	elements int
+	strings  int
+}
+
+// scanMptProofJSON checks the length of the arrays and strings of a JSON encoded proof without
+// unmarshalling it. It runs before the proof is unmarshalled so that a proof within MaxMptProofSize
+// cannot allocate an unbounded number of trie nodes or storage proofs. Arrays of strings, the trie nodes
+// of a proof, are limited to MaxProofNodes elements, other arrays to MaxStorageProofs elements and
+// strings to maxJSONStringLength bytes.
+func scanMptProofJSON(bz []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var containers []*jsonContainer
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) && len(containers) == 0 {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to deserialize mpt proof: %w", err)
+		}
+
+		if delim, ok := token.(json.Delim); ok && (delim == ']' || delim == '}') {
+			containers = containers[:len(containers)-1]
+			continue
+		}
+
+		str, isString := token.(string)
+		if isString && len(str) > maxJSONStringLength {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "json string length %d exceeds the maximum of %d", len(str), maxJSONStringLength)
+		}
+
+		if len(containers) > 0 && containers[len(containers)-1].array {
+			container := containers[len(containers)-1]
+			container.elements++
+			if container.elements > MaxStorageProofs {
+				return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "json array length exceeds the maximum of %d", MaxStorageProofs)
+			}
+			if isString {
+				container.strings++
+				if container.strings > MaxProofNodes {
+					return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "number of trie nodes exceeds the maximum of %d", MaxProofNodes)
+				}
+			}
+		}
+
+		if delim, ok := token.(json.Delim); ok {
+			containers = append(containers, &jsonContainer{array: delim == '['})
+		}
+	}
+}
+
+// ValidateBasic checks that the fixed size fields of the proof have the expected lengths, that the
+// balance and storage values are encoded without leading zeroes and that the number and size of the
+// trie nodes are within MaxProofNodes and MaxProofNodeSize. The storage nodes of a multiproof are
+// limited to MaxStorageNodes instead and the storage proofs must not carry trie nodes of their own.
+func (p *MptProof) ValidateBasic() error {
+	if len(p.Address) != common.AddressLength {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected address length %d, got %d", common.AddressLength, len(p.Address))
+	}
+	if len(p.Balance) != 0 && p.Balance[0] == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "balance has leading zeroes")
+	}
+	if len(p.CodeHash) != common.HashLength {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected code hash length %d, got %d", common.HashLength, len(p.CodeHash))
+	}
+	if len(p.StorageHash) != common.HashLength {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected storage hash length %d, got %d", common.HashLength, len(p.StorageHash))
+	}
+	if err := validateProofNodes(p.AccountProof); err != nil {
+		return sdkerrors.Wrap(err, "account proof")
+	}
+
+	if len(p.StorageProofs) > MaxStorageProofs {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "number of storage proofs %d exceeds the maximum of %d", len(p.StorageProofs), MaxStorageProofs)
+	}
+
+	for i, storageProof := range p.StorageProofs {
+		if storageProof == nil {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage proof %d cannot be nil", i)
+		}
+		if len(storageProof.Key) != common.HashLength {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage proof %d: expected key length %d, got %d", i, common.HashLength, len(storageProof.Key))
+		}
+		if len(storageProof.Value) > common.HashLength {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage proof %d: value is longer than %d bytes", i, common.HashLength)
+		}
+		if len(storageProof.Value) != 0 && storageProof.Value[0] == 0 {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage proof %d: value has leading zeroes", i)
+		}
+		if p.isMultiProof() && len(storageProof.Proof) != 0 {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage proof %d: trie nodes must be part of the storage nodes", i)
+		}
+		if err := validateProofNodes(storageProof.Proof); err != nil {
+			return sdkerrors.Wrapf(err, "storage proof %d", i)
+		}
+	}
+
+	if len(p.StorageNodes) > MaxStorageNodes {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "number of storage nodes %d exceeds the maximum of %d", len(p.StorageNodes), MaxStorageNodes)
+	}
+	for i, node := range p.StorageNodes {
+		if len(node) > MaxProofNodeSize {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "storage node %d size %d exceeds the maximum of %d bytes", i, len(node), MaxProofNodeSize)
+		}
+	}
+
+	return nil
+}
+
+// isMultiProof returns true if the storage slots of the proof are proven by a multiproof of the storage
+// nodes rather than by a proof per storage slot.
+func (p *MptProof) isMultiProof() bool {
+	return len(p.StorageNodes) != 0
+}
+
+// validateProofNodes checks that the number and size of the trie nodes of a proof are within
+// MaxProofNodes and MaxProofNodeSize.
+func validateProofNodes(nodes [][]byte) error {
+	if len(nodes) > MaxProofNodes {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "number of trie nodes %d exceeds the maximum of %d", len(nodes), MaxProofNodes)
+	}
+	for i, node := range nodes {
+		if len(node) > MaxProofNodeSize {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "trie node %d size %d exceeds the maximum of %d bytes", i, len(node), MaxProofNodeSize)
+		}
+	}
+
+	return nil
+}
+
+// storageProof returns the storage proof of the storage slot.
+func (p *MptProof) storageProof(storageKey common.Hash) (*MptStorageProof, error) {
+	for _, storageProof := range p.StorageProofs {
+		if common.BytesToHash(storageProof.Key) == storageKey {
+			return storageProof, nil
+		}
+	}
+
+	return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "proof does not contain storage key %s", storageKey)
+}
+
+// nodeBytes converts hex encoded trie nodes into raw trie nodes.
+func nodeBytes(nodes []hexutil.Bytes) [][]byte {
+	bz := make([][]byte, len(nodes))
+	for i, node := range nodes {
+		bz[i] = node
+	}
+	return bz
+}
+
+// hexNodes converts raw trie nodes into hex encoded trie nodes.
+func hexNodes(nodes [][]byte) []hexutil.Bytes {
+	hexBz := make([]hexutil.Bytes, len(nodes))
+	for i, node := range nodes {
+		hexBz[i] = node
+	}
+	return hexBz
+}
@@ -0,0 +1,247 @@
+package groth16
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestScanMptProofProto(t *testing.T) {
+	base, err := EncodeMptProof(newTestMptProof())
+	require.NoError(t, err)
+	// the canonical encoding without the version byte
+	base = base[1:]
+
+	node := make([]byte, 32)
+	testCases := []struct {
+		name   string
+		proof  func() []byte
+		expErr error
+	}{
+		{
+			"success",
+			func() []byte {
+				bz := appendBytesFields(base, mptProofAccountProofField, node, MaxProofNodes)
+				bz = appendStorageProofs(bz, node, MaxProofNodes, 1)
+				return appendStorageProofs(bz, nil, 0, MaxStorageProofs-1)
+			},
+			nil,
+		},
+		{
+			"too many account proof nodes",
+			func() []byte { return appendBytesFields(base, mptProofAccountProofField, nil, MaxProofNodes+1) },
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"account proof node too large",
+			func() []byte {
+				return appendBytesFields(base, mptProofAccountProofField, make([]byte, MaxProofNodeSize+1), 1)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"too many storage proofs",
+			func() []byte { return appendStorageProofs(base, nil, 0, MaxStorageProofs+1) },
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"too many storage proof nodes",
+			func() []byte { return appendStorageProofs(base, nil, MaxProofNodes+1, 1) },
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"storage proof node too large",
+			func() []byte { return appendStorageProofs(base, make([]byte, MaxProofNodeSize+1), 1, 1) },
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"too many storage nodes",
+			func() []byte { return appendBytesFields(base, mptProofStorageNodesField, nil, MaxStorageNodes+1) },
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"storage node too large",
+			func() []byte {
+				return appendBytesFields(base, mptProofStorageNodesField, make([]byte, MaxProofNodeSize+1), 1)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"truncated field",
+			func() []byte {
+				bz := appendBytesFields(base, mptProofAccountProofField, node, 1)
+				return bz[:len(bz)-1]
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := scanMptProofProto(tc.proof())
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestScanMptProofJSON(t *testing.T) {
+	node := hexutil.Bytes(make([]byte, 32))
+
+	testCases := []struct {
+		name   string
+		proof  func() any
+		expErr error
+	}{
+		{
+			"success: single key proof",
+			func() any {
+				proof := newTestMptProofJSON()
+				proof.AccountProof = repeatNodes(node, MaxProofNodes)
+				proof.StorageProof = repeatNodes(hexutil.Bytes(make([]byte, MaxProofNodeSize)), MaxProofNodes)
+				return proof
+			},
+			nil,
+		},
+		{
+			"success: batch proof",
+			func() any {
+				return newTestMptBatchProofJSON(MaxStorageProofs, repeatNodes(node, MaxProofNodes))
+			},
+			nil,
+		},
+		{
+			"too many account proof nodes",
+			func() any {
+				proof := newTestMptProofJSON()
+				proof.AccountProof = repeatNodes(node, MaxProofNodes+1)
+				return proof
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"too many storage proof nodes",
+			func() any {
+				proof := newTestMptProofJSON()
+				proof.StorageProof = repeatNodes(node, MaxProofNodes+1)
+				return proof
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"trie node too large",
+			func() any {
+				proof := newTestMptProofJSON()
+				proof.AccountProof = repeatNodes(hexutil.Bytes(make([]byte, MaxProofNodeSize+1)), 1)
+				return proof
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"too many storage proofs",
+			func() any { return newTestMptBatchProofJSON(MaxStorageProofs+1, nil) },
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"too many batch storage proof nodes",
+			func() any { return newTestMptBatchProofJSON(1, repeatNodes(node, MaxProofNodes+1)) },
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"too many elements of an unknown field",
+			func() any {
+				return map[string]any{"unknown": make([]int, MaxStorageProofs+1)}
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"unknown string too long",
+			func() any {
+				return map[string]any{"unknown": strings.Repeat("0", maxJSONStringLength+1)}
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := json.Marshal(tc.proof())
+			require.NoError(t, err)
+
+			err = scanMptProofJSON(bz)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+
+	// malformed JSON is rejected as well
+	require.Error(t, scanMptProofJSON([]byte(`{"accountProof": [`)))
+}
+
+// newTestMptProof returns an MptProof without trie nodes that passes ValidateBasic.
+func newTestMptProof() *MptProof {
+	return &MptProof{
+		Address:     testRouterAddress.Bytes(),
+		CodeHash:    make([]byte, common.HashLength),
+		StorageHash: make([]byte, common.HashLength),
+	}
+}
+
+// newTestMptProofJSON returns a single key JSON proof without trie nodes.
+func newTestMptProofJSON() *MptProofJSON {
+	return &MptProofJSON{
+		Address: testRouterAddress,
+		Balance: new(hexutil.Big),
+	}
+}
+
+// newTestMptBatchProofJSON returns a JSON batch proof with the number of storage proofs, each with the
+// trie nodes.
+func newTestMptBatchProofJSON(storageProofs int, nodes []hexutil.Bytes) *MptBatchProofJSON {
+	proof := &MptBatchProofJSON{
+		Address: testRouterAddress,
+		Balance: new(hexutil.Big),
+	}
+	for i := 0; i < storageProofs; i++ {
+		proof.StorageProof = append(proof.StorageProof, StorageProofJSON{Proof: nodes})
+	}
+	return proof
+}
+
+// repeatNodes returns a list of count copies of the trie node.
+func repeatNodes(node hexutil.Bytes, count int) []hexutil.Bytes {
+	nodes := make([]hexutil.Bytes, count)
+	for i := range nodes {
+		nodes[i] = node
+	}
+	return nodes
+}
+
+// appendBytesFields appends count length delimited fields with the field number and value.
+func appendBytesFields(bz []byte, num protowire.Number, value []byte, count int) []byte {
+	bz = append([]byte(nil), bz...)
+	for i := 0; i < count; i++ {
+		bz = protowire.AppendTag(bz, num, protowire.BytesType)
+		bz = protowire.AppendBytes(bz, value)
+	}
+	return bz
+}
+
+// appendStorageProofs appends count storage proofs, each with the given number of copies of the trie
+// node.
+func appendStorageProofs(bz []byte, node []byte, nodes int, count int) []byte {
+	storageProof := appendBytesFields(nil, mptStorageProofProofField, node, nodes)
+	return appendBytesFields(bz, mptProofStorageProofsField, storageProof, count)
+}
@@ -0,0 +1,92 @@
+package groth16
+
+import (
+	"bytes"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// CheckSubstituteAndUpdateState will try to update the client with the state of the
+// substitute.
+//
+// The following must always be true:
+//   - The substitute client is the same type as the subject client
+//   - The subject and substitute client states match in all parameters (except chain ID, revision number, frozen height, latest height, and trusting period)
+//
+// If the subject client is frozen, it will be unfrozen by resetting the FrozenHeight
+// to zero. The latest consensus state of the substitute client and its
+// metadata are copied into the subject client store.
+func (cs *ClientState) CheckSubstituteAndUpdateState(
+	ctx sdktypes.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore storetypes.KVStore, substituteClient exported.ClientState,
+) error {
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, substituteClient)
+	}
+
+	if !IsMatchingClientState(cs, substituteClientState) {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "subject client state does not match substitute client state")
+	}
+
+	if cs.status(ctx, subjectClientStore, cdc) == exported.Frozen {
+		// unfreeze the client
+		cs.FrozenHeight = 0
+	}
+
+	// copy the latest consensus state and its metadata from substitute to subject
+	height := substituteClientState.GetLatestClientHeight()
+
+	consensusState, err := GetConsensusState(substituteClientStore, cdc, height)
+	if err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrConsensusStateNotFound, "unable to retrieve latest consensus state for substitute client")
+	}
+
+	SetConsensusState(subjectClientStore, cdc, consensusState, height)
+
+	// set metadata stored for the substitute consensus state
+	processedHeight, found := GetProcessedHeight(substituteClientStore, height)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed height for substitute client latest height")
+	}
+
+	processedTime, found := GetProcessedTime(substituteClientStore, height)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed time for substitute client latest height")
+	}
+
+	setConsensusMetadataWithValues(subjectClientStore, height, processedHeight, processedTime)
+
+	// the substitute may track a new revision of the rollup
+	cs.ChainId = substituteClientState.ChainId
+	cs.LatestHeight = substituteClientState.LatestHeight
+	cs.RevisionNumber = substituteClientState.RevisionNumber
+
+	// set new trusting period and max clock drift based on the substitute client state
+	cs.TrustingPeriod = substituteClientState.TrustingPeriod
+	cs.MaxClockDrift = substituteClientState.MaxClockDrift
+
+	// no validation is necessary since the substitute is verified to be Active
+	// in 02-client.
+
+	setClientState(subjectClientStore, cdc, cs)
+
+	return nil
+}
+
+// IsMatchingClientState returns true if the subject and substitute client states track the same
+// rollup program. The verifier keys, the proof system, the genesis state root and the ICS26Router address must match,
+// the chain ID, revision number, frozen height, latest height and trusting period may differ.
+func IsMatchingClientState(subject, substitute *ClientState) bool {
+	return subject.StateTransitionVerifierKey == substitute.StateTransitionVerifierKey &&
+		bytes.Equal(subject.StateMembershipVerifierKey, substitute.StateMembershipVerifierKey) &&
+		bytes.Equal(subject.Groth16Vk, substitute.Groth16Vk) &&
+		subject.ProofSystem == substitute.ProofSystem &&
+		bytes.Equal(subject.GenesisStateRoot, substitute.GenesisStateRoot) &&
+		bytes.Equal(subject.Ics26RouterAddress, substitute.Ics26RouterAddress)
+}
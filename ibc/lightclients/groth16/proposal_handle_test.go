@@ -0,0 +1,177 @@
+package groth16
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	testSubstituteClientID = "08-groth16-1"
+	// testSubstituteHeight is the height of the initial consensus state of substitute clients.
+	testSubstituteHeight = 20
+)
+
+func TestRecoverClient(t *testing.T) {
+	substituteTimestamp := testGenesisTime.Add(30 * time.Minute)
+	substituteBlockTime := testGenesisTime.Add(45 * time.Minute)
+
+	c := newTestClient(t)
+	subject := c.clientState(t)
+	subject.FrozenHeight = 1
+	setClientState(c.clientStore(), c.cdc, subject)
+	require.Equal(t, exported.Frozen, c.module.Status(c.ctx, testClientID))
+
+	substitute := newTestClientState()
+	substitute.LatestHeight = testSubstituteHeight
+	substitute.TrustingPeriod = durationpb.New(3 * week)
+	substitute.MaxClockDrift = durationpb.New(time.Minute)
+	substituteConsensusState := NewConsensusState(substituteTimestamp, testStateRoot(testSubstituteHeight), testHeaderHash(testSubstituteHeight))
+	substituteCtx := c.ctx.WithBlockTime(substituteBlockTime).WithBlockHeight(7)
+	require.NoError(t, c.module.Initialize(substituteCtx, testSubstituteClientID, c.cdc.MustMarshal(substitute), c.cdc.MustMarshal(substituteConsensusState)))
+
+	require.NoError(t, c.module.RecoverClient(c.ctx, testClientID, testSubstituteClientID))
+
+	clientState := c.clientState(t)
+	require.Zero(t, clientState.FrozenHeight)
+	require.Equal(t, uint64(testSubstituteHeight), clientState.LatestHeight)
+	require.Equal(t, substitute.TrustingPeriod.AsDuration(), clientState.TrustingPeriod.AsDuration())
+	require.Equal(t, substitute.MaxClockDrift.AsDuration(), clientState.MaxClockDrift.AsDuration())
+	require.Equal(t, exported.Active, c.module.Status(c.ctx, testClientID))
+
+	height := clienttypes.NewHeight(0, testSubstituteHeight)
+	require.Equal(t, c.cdc.MustMarshal(substituteConsensusState), c.cdc.MustMarshal(c.consensusState(t, testSubstituteHeight)))
+
+	processedTime, found := GetProcessedTime(c.clientStore(), height)
+	require.True(t, found)
+	require.Equal(t, uint64(substituteBlockTime.UnixNano()), processedTime)
+
+	processedHeight, found := GetProcessedHeight(c.clientStore(), height)
+	require.True(t, found)
+	require.Equal(t, clienttypes.NewHeight(0, 7), processedHeight)
+
+	require.Equal(t, host.ConsensusStateKey(height), GetIterationKey(c.clientStore(), height))
+}
+
+func TestRecoverClientRevisionBump(t *testing.T) {
+	c := newTestClient(t)
+	c.update(t, newTestHeader(t, testTrustedHeight, testTrustedHeight+1, testGenesisTime.Add(time.Minute)))
+
+	// the roll-up restarts at a new revision whose heights start over
+	substitute := newTestClientState()
+	substitute.LatestHeight = 5
+	substitute.RevisionNumber = 1
+	substituteConsensusState := NewConsensusState(testGenesisTime.Add(10*time.Minute), testStateRoot(5), testHeaderHash(5))
+	require.NoError(t, c.module.Initialize(c.ctx, testSubstituteClientID, c.cdc.MustMarshal(substitute), c.cdc.MustMarshal(substituteConsensusState)))
+
+	require.NoError(t, c.module.RecoverClient(c.ctx, testClientID, testSubstituteClientID))
+	require.Equal(t, clienttypes.NewHeight(1, 5), c.module.LatestHeight(c.ctx, testClientID))
+	require.Equal(t, exported.Active, c.module.Status(c.ctx, testClientID))
+
+	// headers of the previous revision are rejected
+	err := c.module.VerifyClientMessage(c.ctx, testClientID, newTestHeader(t, testTrustedHeight+1, testTrustedHeight+2, testGenesisTime.Add(11*time.Minute)))
+	require.ErrorIs(t, err, ErrInvalidHeaderHeight)
+
+	header, err := NewHeader(nil, encodePublicValues(newTestOutput(5, 6)), 1, testGenesisTime.Add(11*time.Minute))
+	require.NoError(t, err)
+	c.update(t, header)
+
+	require.Equal(t, clienttypes.NewHeight(1, 6), c.module.LatestHeight(c.ctx, testClientID))
+	consensusState, err := GetConsensusState(c.clientStore(), c.cdc, clienttypes.NewHeight(1, 6))
+	require.NoError(t, err)
+	require.Equal(t, testStateRoot(6), consensusState.StateRoot)
+
+	// consensus states of the previous revision are kept until they expire
+	_, err = GetConsensusState(c.clientStore(), c.cdc, clienttypes.NewHeight(0, testTrustedHeight+1))
+	require.NoError(t, err)
+}
+
+func TestRecoverClientMismatchedSubstitute(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(substitute *ClientState)
+	}{
+		{
+			"state transition verifier key",
+			func(substitute *ClientState) {
+				substitute.StateTransitionVerifierKey = "0x" + common.Bytes2Hex(sha256Bytes("other program"))
+			},
+		},
+		{
+			"state membership verifier key",
+			func(substitute *ClientState) {
+				substitute.StateMembershipVerifierKey = sha256Bytes("other membership program")
+			},
+		},
+		{
+			"groth16 verifying key",
+			func(substitute *ClientState) {
+				substitute.Groth16Vk = []byte("other groth16 vk")
+			},
+		},
+		{
+			"proof system",
+			func(substitute *ClientState) {
+				substitute.ProofSystem = ProofSystem_PROOF_SYSTEM_SP1_GROTH16
+			},
+		},
+		{
+			"genesis state root",
+			func(substitute *ClientState) {
+				substitute.GenesisStateRoot = testStateRoot(1)
+			},
+		},
+		{
+			"router address",
+			func(substitute *ClientState) {
+				substitute.Ics26RouterAddress = common.HexToAddress("0x0000000000000000000000000000000000000001").Bytes()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t)
+
+			substitute := newTestClientState()
+			substitute.LatestHeight = testSubstituteHeight
+			tc.malleate(substitute)
+			substituteConsensusState := NewConsensusState(testGenesisTime, testStateRoot(testSubstituteHeight), testHeaderHash(testSubstituteHeight))
+			// the substitute is stored without validation so that any field can be changed
+			substituteStore := c.module.storeProvider.ClientStore(c.ctx, testSubstituteClientID)
+			require.NoError(t, substitute.initialize(c.ctx, c.cdc, substituteStore, substituteConsensusState))
+
+			err := c.module.RecoverClient(c.ctx, testClientID, testSubstituteClientID)
+			require.ErrorIs(t, err, clienttypes.ErrInvalidSubstitute)
+
+			// the subject client is left untouched
+			require.Equal(t, c.cdc.MustMarshal(newTestClientState()), c.cdc.MustMarshal(c.clientState(t)))
+			_, err = GetConsensusState(c.clientStore(), c.cdc, clienttypes.NewHeight(0, testSubstituteHeight))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRecoverClientInvalidSubstitute(t *testing.T) {
+	c := newTestClient(t)
+
+	err := c.module.RecoverClient(c.ctx, testClientID, "07-tendermint-0")
+	require.ErrorIs(t, err, clienttypes.ErrInvalidClientType)
+
+	err = c.module.RecoverClient(c.ctx, testClientID, testSubstituteClientID)
+	require.ErrorIs(t, err, clienttypes.ErrClientNotFound)
+
+	err = c.module.RecoverClient(c.ctx, testSubstituteClientID, testClientID)
+	require.ErrorIs(t, err, clienttypes.ErrClientNotFound)
+
+	substituteStore := c.module.storeProvider.ClientStore(c.ctx, "07-tendermint-0")
+	err = c.clientState(t).CheckSubstituteAndUpdateState(c.ctx, c.cdc, c.clientStore(), substituteStore, &ibctm.ClientState{})
+	require.ErrorIs(t, err, clienttypes.ErrInvalidClient)
+}
@@ -0,0 +1,1126 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: ibc/lightclients/groth16/v1/query.proto
+
+package groth16
+
+import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConsensusStateWithHeight is a consensus state together with the height it
+// is stored at.
+type ConsensusStateWithHeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height         *Height         `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
+	ConsensusState *ConsensusState `protobuf:"bytes,2,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+}
+
+func (x *ConsensusStateWithHeight) Reset() {
+	*x = ConsensusStateWithHeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsensusStateWithHeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusStateWithHeight) ProtoMessage() {}
+
+func (x *ConsensusStateWithHeight) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusStateWithHeight.ProtoReflect.Descriptor instead.
+func (*ConsensusStateWithHeight) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConsensusStateWithHeight) GetHeight() *Height {
+	if x != nil {
+		return x.Height
+	}
+	return nil
+}
+
+func (x *ConsensusStateWithHeight) GetConsensusState() *ConsensusState {
+	if x != nil {
+		return x.ConsensusState
+	}
+	return nil
+}
+
+// QueryConsensusStatesRequest is the request type for the Query/ConsensusStates
+// RPC method.
+type QueryConsensusStatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// pagination request
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryConsensusStatesRequest) Reset() {
+	*x = QueryConsensusStatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryConsensusStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryConsensusStatesRequest) ProtoMessage() {}
+
+func (x *QueryConsensusStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryConsensusStatesRequest.ProtoReflect.Descriptor instead.
+func (*QueryConsensusStatesRequest) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryConsensusStatesRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *QueryConsensusStatesRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryConsensusStatesResponse is the response type for the
+// Query/ConsensusStates RPC method.
+type QueryConsensusStatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// consensus states in ascending height order
+	ConsensusStates []*ConsensusStateWithHeight `protobuf:"bytes,1,rep,name=consensus_states,json=consensusStates,proto3" json:"consensus_states,omitempty"`
+	// pagination response
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryConsensusStatesResponse) Reset() {
+	*x = QueryConsensusStatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryConsensusStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryConsensusStatesResponse) ProtoMessage() {}
+
+func (x *QueryConsensusStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryConsensusStatesResponse.ProtoReflect.Descriptor instead.
+func (*QueryConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryConsensusStatesResponse) GetConsensusStates() []*ConsensusStateWithHeight {
+	if x != nil {
+		return x.ConsensusStates
+	}
+	return nil
+}
+
+func (x *QueryConsensusStatesResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryConsensusStateRequest is the request type for the Query/ConsensusState
+// RPC method.
+type QueryConsensusStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// consensus state revision number
+	RevisionNumber uint64 `protobuf:"varint,2,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	// consensus state revision height
+	RevisionHeight uint64 `protobuf:"varint,3,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
+}
+
+func (x *QueryConsensusStateRequest) Reset() {
+	*x = QueryConsensusStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryConsensusStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryConsensusStateRequest) ProtoMessage() {}
+
+func (x *QueryConsensusStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryConsensusStateRequest.ProtoReflect.Descriptor instead.
+func (*QueryConsensusStateRequest) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryConsensusStateRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *QueryConsensusStateRequest) GetRevisionNumber() uint64 {
+	if x != nil {
+		return x.RevisionNumber
+	}
+	return 0
+}
+
+func (x *QueryConsensusStateRequest) GetRevisionHeight() uint64 {
+	if x != nil {
+		return x.RevisionHeight
+	}
+	return 0
+}
+
+// QueryConsensusStateResponse is the response type for the
+// Query/ConsensusState RPC method.
+type QueryConsensusStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsensusState *ConsensusStateWithHeight `protobuf:"bytes,1,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+	// processed_time is the block time of the host chain at which the consensus
+	// state was stored.
+	ProcessedTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=processed_time,json=processedTime,proto3" json:"processed_time,omitempty"`
+	// processed_height is the height of the host chain at which the consensus
+	// state was stored.
+	ProcessedHeight *Height `protobuf:"bytes,3,opt,name=processed_height,json=processedHeight,proto3" json:"processed_height,omitempty"`
+}
+
+func (x *QueryConsensusStateResponse) Reset() {
+	*x = QueryConsensusStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryConsensusStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryConsensusStateResponse) ProtoMessage() {}
+
+func (x *QueryConsensusStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryConsensusStateResponse.ProtoReflect.Descriptor instead.
+func (*QueryConsensusStateResponse) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryConsensusStateResponse) GetConsensusState() *ConsensusStateWithHeight {
+	if x != nil {
+		return x.ConsensusState
+	}
+	return nil
+}
+
+func (x *QueryConsensusStateResponse) GetProcessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedTime
+	}
+	return nil
+}
+
+func (x *QueryConsensusStateResponse) GetProcessedHeight() *Height {
+	if x != nil {
+		return x.ProcessedHeight
+	}
+	return nil
+}
+
+// QueryVerifierKeysRequest is the request type for the Query/VerifierKeys RPC
+// method.
+type QueryVerifierKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (x *QueryVerifierKeysRequest) Reset() {
+	*x = QueryVerifierKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVerifierKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVerifierKeysRequest) ProtoMessage() {}
+
+func (x *QueryVerifierKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryVerifierKeysRequest.ProtoReflect.Descriptor instead.
+func (*QueryVerifierKeysRequest) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryVerifierKeysRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+// QueryVerifierKeysResponse is the response type for the Query/VerifierKeys
+// RPC method.
+type QueryVerifierKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StateTransitionVerifierKey string `protobuf:"bytes,1,opt,name=state_transition_verifier_key,json=stateTransitionVerifierKey,proto3" json:"state_transition_verifier_key,omitempty"`
+	StateMembershipVerifierKey []byte `protobuf:"bytes,2,opt,name=state_membership_verifier_key,json=stateMembershipVerifierKey,proto3" json:"state_membership_verifier_key,omitempty"`
+	Groth16Vk                  []byte `protobuf:"bytes,3,opt,name=groth16_vk,json=groth16Vk,proto3" json:"groth16_vk,omitempty"`
+}
+
+func (x *QueryVerifierKeysResponse) Reset() {
+	*x = QueryVerifierKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVerifierKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVerifierKeysResponse) ProtoMessage() {}
+
+func (x *QueryVerifierKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryVerifierKeysResponse.ProtoReflect.Descriptor instead.
+func (*QueryVerifierKeysResponse) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryVerifierKeysResponse) GetStateTransitionVerifierKey() string {
+	if x != nil {
+		return x.StateTransitionVerifierKey
+	}
+	return ""
+}
+
+func (x *QueryVerifierKeysResponse) GetStateMembershipVerifierKey() []byte {
+	if x != nil {
+		return x.StateMembershipVerifierKey
+	}
+	return nil
+}
+
+func (x *QueryVerifierKeysResponse) GetGroth16Vk() []byte {
+	if x != nil {
+		return x.Groth16Vk
+	}
+	return nil
+}
+
+// QueryPreviousConsensusStateRequest is the request type for the
+// Query/PreviousConsensusState RPC method.
+type QueryPreviousConsensusStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// revision number of the height to search below
+	RevisionNumber uint64 `protobuf:"varint,2,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	// revision height of the height to search below
+	RevisionHeight uint64 `protobuf:"varint,3,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
+}
+
+func (x *QueryPreviousConsensusStateRequest) Reset() {
+	*x = QueryPreviousConsensusStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPreviousConsensusStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPreviousConsensusStateRequest) ProtoMessage() {}
+
+func (x *QueryPreviousConsensusStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPreviousConsensusStateRequest.ProtoReflect.Descriptor instead.
+func (*QueryPreviousConsensusStateRequest) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryPreviousConsensusStateRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *QueryPreviousConsensusStateRequest) GetRevisionNumber() uint64 {
+	if x != nil {
+		return x.RevisionNumber
+	}
+	return 0
+}
+
+func (x *QueryPreviousConsensusStateRequest) GetRevisionHeight() uint64 {
+	if x != nil {
+		return x.RevisionHeight
+	}
+	return 0
+}
+
+// QueryPreviousConsensusStateResponse is the response type for the
+// Query/PreviousConsensusState RPC method.
+type QueryPreviousConsensusStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsensusState *ConsensusStateWithHeight `protobuf:"bytes,1,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+}
+
+func (x *QueryPreviousConsensusStateResponse) Reset() {
+	*x = QueryPreviousConsensusStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPreviousConsensusStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPreviousConsensusStateResponse) ProtoMessage() {}
+
+func (x *QueryPreviousConsensusStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPreviousConsensusStateResponse.ProtoReflect.Descriptor instead.
+func (*QueryPreviousConsensusStateResponse) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryPreviousConsensusStateResponse) GetConsensusState() *ConsensusStateWithHeight {
+	if x != nil {
+		return x.ConsensusState
+	}
+	return nil
+}
+
+// QueryNextConsensusStateRequest is the request type for the
+// Query/NextConsensusState RPC method.
+type QueryNextConsensusStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// revision number of the height to search above
+	RevisionNumber uint64 `protobuf:"varint,2,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	// revision height of the height to search above
+	RevisionHeight uint64 `protobuf:"varint,3,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
+}
+
+func (x *QueryNextConsensusStateRequest) Reset() {
+	*x = QueryNextConsensusStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryNextConsensusStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNextConsensusStateRequest) ProtoMessage() {}
+
+func (x *QueryNextConsensusStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryNextConsensusStateRequest.ProtoReflect.Descriptor instead.
+func (*QueryNextConsensusStateRequest) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryNextConsensusStateRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *QueryNextConsensusStateRequest) GetRevisionNumber() uint64 {
+	if x != nil {
+		return x.RevisionNumber
+	}
+	return 0
+}
+
+func (x *QueryNextConsensusStateRequest) GetRevisionHeight() uint64 {
+	if x != nil {
+		return x.RevisionHeight
+	}
+	return 0
+}
+
+// QueryNextConsensusStateResponse is the response type for the
+// Query/NextConsensusState RPC method.
+type QueryNextConsensusStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsensusState *ConsensusStateWithHeight `protobuf:"bytes,1,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+}
+
+func (x *QueryNextConsensusStateResponse) Reset() {
+	*x = QueryNextConsensusStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryNextConsensusStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNextConsensusStateResponse) ProtoMessage() {}
+
+func (x *QueryNextConsensusStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ibc_lightclients_groth16_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryNextConsensusStateResponse.ProtoReflect.Descriptor instead.
+func (*QueryNextConsensusStateResponse) Descriptor() ([]byte, []int) {
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryNextConsensusStateResponse) GetConsensusState() *ConsensusStateWithHeight {
+	if x != nil {
+		return x.ConsensusState
+	}
+	return nil
+}
+
+var File_ibc_lightclients_groth16_v1_query_proto protoreflect.FileDescriptor
+
+var file_ibc_lightclients_groth16_v1_query_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x24, 0x63, 0x65, 0x6c, 0x65, 0x73,
+	0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x1a,
+	0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x69, 0x62, 0x63, 0x2f,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x6f,
+	0x74, 0x68, 0x31, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62,
+	0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67,
+	0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x5d, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63,
+	0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0x01, 0x0a,
+	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a,
+	0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74,
+	0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xa2, 0x02, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73,
+	0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61,
+	0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x37, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xc0, 0x01,
+	0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x1a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x41,
+	0x0a, 0x1d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x5f, 0x76, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x56, 0x6b,
+	0x22, 0x93, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74,
+	0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x1f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69,
+	0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x32, 0xf3, 0x0a, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0xec, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x41, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e,
+	0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x42, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74,
+	0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x4c, 0x12, 0x4a, 0x2f, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2f, 0x69,
+	0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x9f, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x40, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62,
+	0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67,
+	0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e,
+	0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x80,
+	0x01, 0x12, 0x7e, 0x2f, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2f, 0x69, 0x62, 0x63,
+	0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72,
+	0x6f, 0x74, 0x68, 0x31, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x7b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2f,
+	0x7b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x7d, 0x12, 0xe0, 0x01, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x3e, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62,
+	0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67,
+	0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62,
+	0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67,
+	0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x12, 0x47, 0x2f, 0x63, 0x65,
+	0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2f, 0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x12, 0xc1, 0x02, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x48, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74,
+	0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x63, 0x65, 0x6c, 0x65,
+	0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x91, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x8a, 0x01, 0x12, 0x87,
+	0x01, 0x2f, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2f, 0x69, 0x62, 0x63, 0x2f, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x74,
+	0x68, 0x31, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2f, 0x7b, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x2f,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0xb1, 0x02, 0x0a, 0x12, 0x4e, 0x65, 0x78,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x44, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61, 0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74,
+	0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x78, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74, 0x69, 0x61,
+	0x2e, 0x69, 0x62, 0x63, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x86, 0x01, 0x12, 0x83, 0x01, 0x2f, 0x63, 0x65, 0x6c, 0x65, 0x73, 0x74,
+	0x69, 0x61, 0x2f, 0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x2f, 0x7b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x2f, 0x6e, 0x65, 0x78, 0x74, 0x42, 0x1a, 0x5a, 0x18,
+	0x69, 0x62, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x67, 0x72, 0x6f, 0x74, 0x68, 0x31, 0x36, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ibc_lightclients_groth16_v1_query_proto_rawDescOnce sync.Once
+	file_ibc_lightclients_groth16_v1_query_proto_rawDescData = file_ibc_lightclients_groth16_v1_query_proto_rawDesc
+)
+
+func file_ibc_lightclients_groth16_v1_query_proto_rawDescGZIP() []byte {
+	file_ibc_lightclients_groth16_v1_query_proto_rawDescOnce.Do(func() {
+		file_ibc_lightclients_groth16_v1_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_ibc_lightclients_groth16_v1_query_proto_rawDescData)
+	})
+	return file_ibc_lightclients_groth16_v1_query_proto_rawDescData
+}
+
+var file_ibc_lightclients_groth16_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_ibc_lightclients_groth16_v1_query_proto_goTypes = []any{
+	(*ConsensusStateWithHeight)(nil),            // 0: celestia.ibc.lightclients.groth16.v1.ConsensusStateWithHeight
+	(*QueryConsensusStatesRequest)(nil),         // 1: celestia.ibc.lightclients.groth16.v1.QueryConsensusStatesRequest
+	(*QueryConsensusStatesResponse)(nil),        // 2: celestia.ibc.lightclients.groth16.v1.QueryConsensusStatesResponse
+	(*QueryConsensusStateRequest)(nil),          // 3: celestia.ibc.lightclients.groth16.v1.QueryConsensusStateRequest
+	(*QueryConsensusStateResponse)(nil),         // 4: celestia.ibc.lightclients.groth16.v1.QueryConsensusStateResponse
+	(*QueryVerifierKeysRequest)(nil),            // 5: celestia.ibc.lightclients.groth16.v1.QueryVerifierKeysRequest
+	(*QueryVerifierKeysResponse)(nil),           // 6: celestia.ibc.lightclients.groth16.v1.QueryVerifierKeysResponse
+	(*QueryPreviousConsensusStateRequest)(nil),  // 7: celestia.ibc.lightclients.groth16.v1.QueryPreviousConsensusStateRequest
+	(*QueryPreviousConsensusStateResponse)(nil), // 8: celestia.ibc.lightclients.groth16.v1.QueryPreviousConsensusStateResponse
+	(*QueryNextConsensusStateRequest)(nil),      // 9: celestia.ibc.lightclients.groth16.v1.QueryNextConsensusStateRequest
+	(*QueryNextConsensusStateResponse)(nil),     // 10: celestia.ibc.lightclients.groth16.v1.QueryNextConsensusStateResponse
+	(*Height)(nil),                              // 11: celestia.ibc.lightclients.groth16.v1.Height
+	(*ConsensusState)(nil),                      // 12: celestia.ibc.lightclients.groth16.v1.ConsensusState
+	(*v1beta1.PageRequest)(nil),                 // 13: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),                // 14: cosmos.base.query.v1beta1.PageResponse
+	(*timestamppb.Timestamp)(nil),               // 15: google.protobuf.Timestamp
+}
+var file_ibc_lightclients_groth16_v1_query_proto_depIdxs = []int32{
+	11, // 0: celestia.ibc.lightclients.groth16.v1.ConsensusStateWithHeight.height:type_name -> celestia.ibc.lightclients.groth16.v1.Height
+	12, // 1: celestia.ibc.lightclients.groth16.v1.ConsensusStateWithHeight.consensus_state:type_name -> celestia.ibc.lightclients.groth16.v1.ConsensusState
+	13, // 2: celestia.ibc.lightclients.groth16.v1.QueryConsensusStatesRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	0,  // 3: celestia.ibc.lightclients.groth16.v1.QueryConsensusStatesResponse.consensus_states:type_name -> celestia.ibc.lightclients.groth16.v1.ConsensusStateWithHeight
+	14, // 4: celestia.ibc.lightclients.groth16.v1.QueryConsensusStatesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 5: celestia.ibc.lightclients.groth16.v1.QueryConsensusStateResponse.consensus_state:type_name -> celestia.ibc.lightclients.groth16.v1.ConsensusStateWithHeight
+	15, // 6: celestia.ibc.lightclients.groth16.v1.QueryConsensusStateResponse.processed_time:type_name -> google.protobuf.Timestamp
+	11, // 7: celestia.ibc.lightclients.groth16.v1.QueryConsensusStateResponse.processed_height:type_name -> celestia.ibc.lightclients.groth16.v1.Height
+	0,  // 8: celestia.ibc.lightclients.groth16.v1.QueryPreviousConsensusStateResponse.consensus_state:type_name -> celestia.ibc.lightclients.groth16.v1.ConsensusStateWithHeight
+	0,  // 9: celestia.ibc.lightclients.groth16.v1.QueryNextConsensusStateResponse.consensus_state:type_name -> celestia.ibc.lightclients.groth16.v1.ConsensusStateWithHeight
+	1,  // 10: celestia.ibc.lightclients.groth16.v1.Query.ConsensusStates:input_type -> celestia.ibc.lightclients.groth16.v1.QueryConsensusStatesRequest
+	3,  // 11: celestia.ibc.lightclients.groth16.v1.Query.ConsensusState:input_type -> celestia.ibc.lightclients.groth16.v1.QueryConsensusStateRequest
+	5,  // 12: celestia.ibc.lightclients.groth16.v1.Query.VerifierKeys:input_type -> celestia.ibc.lightclients.groth16.v1.QueryVerifierKeysRequest
+	7,  // 13: celestia.ibc.lightclients.groth16.v1.Query.PreviousConsensusState:input_type -> celestia.ibc.lightclients.groth16.v1.QueryPreviousConsensusStateRequest
+	9,  // 14: celestia.ibc.lightclients.groth16.v1.Query.NextConsensusState:input_type -> celestia.ibc.lightclients.groth16.v1.QueryNextConsensusStateRequest
+	2,  // 15: celestia.ibc.lightclients.groth16.v1.Query.ConsensusStates:output_type -> celestia.ibc.lightclients.groth16.v1.QueryConsensusStatesResponse
+	4,  // 16: celestia.ibc.lightclients.groth16.v1.Query.ConsensusState:output_type -> celestia.ibc.lightclients.groth16.v1.QueryConsensusStateResponse
+	6,  // 17: celestia.ibc.lightclients.groth16.v1.Query.VerifierKeys:output_type -> celestia.ibc.lightclients.groth16.v1.QueryVerifierKeysResponse
+	8,  // 18: celestia.ibc.lightclients.groth16.v1.Query.PreviousConsensusState:output_type -> celestia.ibc.lightclients.groth16.v1.QueryPreviousConsensusStateResponse
+	10, // 19: celestia.ibc.lightclients.groth16.v1.Query.NextConsensusState:output_type -> celestia.ibc.lightclients.groth16.v1.QueryNextConsensusStateResponse
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_ibc_lightclients_groth16_v1_query_proto_init() }
+func file_ibc_lightclients_groth16_v1_query_proto_init() {
+	if File_ibc_lightclients_groth16_v1_query_proto != nil {
+		return
+	}
+	file_ibc_lightclients_groth16_v1_groth16_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsensusStateWithHeight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryConsensusStatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryConsensusStatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryConsensusStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryConsensusStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryVerifierKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryVerifierKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryPreviousConsensusStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryPreviousConsensusStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryNextConsensusStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ibc_lightclients_groth16_v1_query_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryNextConsensusStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ibc_lightclients_groth16_v1_query_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ibc_lightclients_groth16_v1_query_proto_goTypes,
+		DependencyIndexes: file_ibc_lightclients_groth16_v1_query_proto_depIdxs,
+		MessageInfos:      file_ibc_lightclients_groth16_v1_query_proto_msgTypes,
+	}.Build()
+	File_ibc_lightclients_groth16_v1_query_proto = out.File
+	file_ibc_lightclients_groth16_v1_query_proto_rawDesc = nil
+	file_ibc_lightclients_groth16_v1_query_proto_goTypes = nil
+	file_ibc_lightclients_groth16_v1_query_proto_depIdxs = nil
+}
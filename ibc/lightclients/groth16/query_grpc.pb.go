@@ -0,0 +1,295 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: ibc/lightclients/groth16/v1/query.proto
+
+package groth16
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Query_ConsensusStates_FullMethodName        = "/celestia.ibc.lightclients.groth16.v1.Query/ConsensusStates"
+	Query_ConsensusState_FullMethodName         = "/celestia.ibc.lightclients.groth16.v1.Query/ConsensusState"
+	Query_VerifierKeys_FullMethodName           = "/celestia.ibc.lightclients.groth16.v1.Query/VerifierKeys"
+	Query_PreviousConsensusState_FullMethodName = "/celestia.ibc.lightclients.groth16.v1.Query/PreviousConsensusState"
+	Query_NextConsensusState_FullMethodName     = "/celestia.ibc.lightclients.groth16.v1.Query/NextConsensusState"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query defines the gRPC querier service for the groth16 light client.
+type QueryClient interface {
+	// ConsensusStates returns the consensus states of a groth16 client in
+	// ascending height order.
+	ConsensusStates(ctx context.Context, in *QueryConsensusStatesRequest, opts ...grpc.CallOption) (*QueryConsensusStatesResponse, error)
+	// ConsensusState returns the consensus state of a groth16 client at a height
+	// together with the time and height at which it was processed.
+	ConsensusState(ctx context.Context, in *QueryConsensusStateRequest, opts ...grpc.CallOption) (*QueryConsensusStateResponse, error)
+	// VerifierKeys returns the keys that a groth16 client verifies proofs with.
+	VerifierKeys(ctx context.Context, in *QueryVerifierKeysRequest, opts ...grpc.CallOption) (*QueryVerifierKeysResponse, error)
+	// PreviousConsensusState returns the consensus state with the greatest
+	// height that is lower than the given height.
+	PreviousConsensusState(ctx context.Context, in *QueryPreviousConsensusStateRequest, opts ...grpc.CallOption) (*QueryPreviousConsensusStateResponse, error)
+	// NextConsensusState returns the consensus state with the lowest height that
+	// is greater than the given height.
+	NextConsensusState(ctx context.Context, in *QueryNextConsensusStateRequest, opts ...grpc.CallOption) (*QueryNextConsensusStateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ConsensusStates(ctx context.Context, in *QueryConsensusStatesRequest, opts ...grpc.CallOption) (*QueryConsensusStatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, Query_ConsensusStates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusState(ctx context.Context, in *QueryConsensusStateRequest, opts ...grpc.CallOption) (*QueryConsensusStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryConsensusStateResponse)
+	err := c.cc.Invoke(ctx, Query_ConsensusState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifierKeys(ctx context.Context, in *QueryVerifierKeysRequest, opts ...grpc.CallOption) (*QueryVerifierKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryVerifierKeysResponse)
+	err := c.cc.Invoke(ctx, Query_VerifierKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreviousConsensusState(ctx context.Context, in *QueryPreviousConsensusStateRequest, opts ...grpc.CallOption) (*QueryPreviousConsensusStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPreviousConsensusStateResponse)
+	err := c.cc.Invoke(ctx, Query_PreviousConsensusState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextConsensusState(ctx context.Context, in *QueryNextConsensusStateRequest, opts ...grpc.CallOption) (*QueryNextConsensusStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryNextConsensusStateResponse)
+	err := c.cc.Invoke(ctx, Query_NextConsensusState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility.
+//
+// Query defines the gRPC querier service for the groth16 light client.
+type QueryServer interface {
+	// ConsensusStates returns the consensus states of a groth16 client in
+	// ascending height order.
+	ConsensusStates(context.Context, *QueryConsensusStatesRequest) (*QueryConsensusStatesResponse, error)
+	// ConsensusState returns the consensus state of a groth16 client at a height
+	// together with the time and height at which it was processed.
+	ConsensusState(context.Context, *QueryConsensusStateRequest) (*QueryConsensusStateResponse, error)
+	// VerifierKeys returns the keys that a groth16 client verifies proofs with.
+	VerifierKeys(context.Context, *QueryVerifierKeysRequest) (*QueryVerifierKeysResponse, error)
+	// PreviousConsensusState returns the consensus state with the greatest
+	// height that is lower than the given height.
+	PreviousConsensusState(context.Context, *QueryPreviousConsensusStateRequest) (*QueryPreviousConsensusStateResponse, error)
+	// NextConsensusState returns the consensus state with the lowest height that
+	// is greater than the given height.
+	NextConsensusState(context.Context, *QueryNextConsensusStateRequest) (*QueryNextConsensusStateResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueryServer struct{}
+
+func (UnimplementedQueryServer) ConsensusStates(context.Context, *QueryConsensusStatesRequest) (*QueryConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStates not implemented")
+}
+func (UnimplementedQueryServer) ConsensusState(context.Context, *QueryConsensusStateRequest) (*QueryConsensusStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusState not implemented")
+}
+func (UnimplementedQueryServer) VerifierKeys(context.Context, *QueryVerifierKeysRequest) (*QueryVerifierKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifierKeys not implemented")
+}
+func (UnimplementedQueryServer) PreviousConsensusState(context.Context, *QueryPreviousConsensusStateRequest) (*QueryPreviousConsensusStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviousConsensusState not implemented")
+}
+func (UnimplementedQueryServer) NextConsensusState(context.Context, *QueryNextConsensusStateRequest) (*QueryNextConsensusStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextConsensusState not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+func (UnimplementedQueryServer) testEmbeddedByValue()               {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	// If the following call pancis, it indicates UnimplementedQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_ConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConsensusStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusStates(ctx, req.(*QueryConsensusStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConsensusState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusState(ctx, req.(*QueryConsensusStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifierKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifierKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifierKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifierKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifierKeys(ctx, req.(*QueryVerifierKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreviousConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreviousConsensusStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreviousConsensusState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PreviousConsensusState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreviousConsensusState(ctx, req.(*QueryPreviousConsensusStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextConsensusStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextConsensusState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NextConsensusState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextConsensusState(ctx, req.(*QueryNextConsensusStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.ibc.lightclients.groth16.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConsensusStates",
+			Handler:    _Query_ConsensusStates_Handler,
+		},
+		{
+			MethodName: "ConsensusState",
+			Handler:    _Query_ConsensusState_Handler,
+		},
+		{
+			MethodName: "VerifierKeys",
+			Handler:    _Query_VerifierKeys_Handler,
+		},
+		{
+			MethodName: "PreviousConsensusState",
+			Handler:    _Query_PreviousConsensusState_Handler,
+		},
+		{
+			MethodName: "NextConsensusState",
+			Handler:    _Query_NextConsensusState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/lightclients/groth16/v1/query.proto",
+}
@@ -0,0 +1,60 @@
+package groth16
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// StateTransitionVerifier verifies the state transition proofs of an EVM roll-up for a proof system.
+type StateTransitionVerifier interface {
+	// VerifyStateTransitionProof verifies the proof for the SP1 public values against the SP1 program
+	// verifier key hash (hex encoded) and the verifying key of the proof system.
+	VerifyStateTransitionProof(proof []byte, publicValues []byte, vkeyHash string, verifyingKey []byte) error
+	// ValidateVerifyingKey returns an error if the verifying key is not a valid verifying key of the
+	// proof system.
+	ValidateVerifyingKey(verifyingKey []byte) error
+	// PairingChecks returns the number of pairing checks done to verify a proof. The gas charged for
+	// verifying a proof is PairingCheckGasCost per pairing check.
+	PairingChecks() uint64
+}
+
+var _ StateTransitionVerifier = SP1Groth16Verifier{}
+
+// stateTransitionVerifiers maps the supported proof systems to their state transition verifiers.
+// PROOF_SYSTEM_SP1_PLONK is not supported until its verifier is checked against SP1 PLONK proofs.
+// PROOF_SYSTEM_MOCK is only supported by binaries built with the groth16mock build tag.
+var stateTransitionVerifiers = map[ProofSystem]StateTransitionVerifier{
+	ProofSystem_PROOF_SYSTEM_SP1_GROTH16: SP1Groth16Verifier{},
+}
+
+// GetStateTransitionVerifier returns the state transition verifier of the proof system. An error is
+// returned if the proof system is not supported.
+func GetStateTransitionVerifier(proofSystem ProofSystem) (StateTransitionVerifier, error) {
+	verifier, ok := stateTransitionVerifiers[proofSystem]
+	if !ok && proofSystem == ProofSystem_PROOF_SYSTEM_MOCK {
+		return nil, sdkerrors.Wrapf(ErrInvalidProofSystem, "%s requires a binary built with the groth16mock build tag", proofSystem)
+	}
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidProofSystem, "unsupported proof system %s", proofSystem)
+	}
+
+	return verifier, nil
+}
+
+// SP1Groth16Verifier verifies SP1 Groth16 proofs against a gnark Groth16 verifying key.
+type SP1Groth16Verifier struct{}
+
+// VerifyStateTransitionProof implements StateTransitionVerifier.
+func (SP1Groth16Verifier) VerifyStateTransitionProof(proof []byte, publicValues []byte, vkeyHash string, verifyingKey []byte) error {
+	return VerifySP1Groth16Proof(proof, publicValues, vkeyHash, verifyingKey)
+}
+
+// ValidateVerifyingKey implements StateTransitionVerifier.
+func (SP1Groth16Verifier) ValidateVerifyingKey(verifyingKey []byte) error {
+	_, err := parseGroth16VerifyingKey(verifyingKey)
+	return err
+}
+
+// PairingChecks implements StateTransitionVerifier.
+func (SP1Groth16Verifier) PairingChecks() uint64 {
+	return 1
+}
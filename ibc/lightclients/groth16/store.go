@@ -240,7 +240,11 @@ func deleteIterationKey(clientStore storetypes.KVStore, height exported.Height)
 
 // GetHeightFromIterationKey takes an iteration key and returns the height that it references
 func GetHeightFromIterationKey(iterKey []byte) exported.Height {
-	bigEndianBytes := iterKey[len([]byte(KeyIterateConsensusStatePrefix)):]
+	return getHeightFromBigEndianBytes(iterKey[len([]byte(KeyIterateConsensusStatePrefix)):])
+}
+
+// getHeightFromBigEndianBytes returns the height encoded by bigEndianHeightBytes
+func getHeightFromBigEndianBytes(bigEndianBytes []byte) exported.Height {
 	revisionBytes := bigEndianBytes[0:8]
 	heightBytes := bigEndianBytes[8:]
 	revision := binary.BigEndian.Uint64(revisionBytes)
@@ -269,11 +273,18 @@ func IterateConsensusStateAscending(clientStore storetypes.KVStore, cb func(heig
 // If the starting height exists in store, we need to call iterator.Next() to get the next consenus state.
 // Otherwise, the iterator is already at the next consensus state so we can call iterator.Value() immediately.
 func GetNextConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, height exported.Height) (*ConsensusState, bool) {
+	_, consensusState, found := getNextConsensusStateWithHeight(clientStore, cdc, height)
+	return consensusState, found
+}
+
+// getNextConsensusStateWithHeight returns the lowest consensus state that is larger than the given height
+// together with the height it is stored at.
+func getNextConsensusStateWithHeight(clientStore storetypes.KVStore, cdc codec.BinaryCodec, height exported.Height) (exported.Height, *ConsensusState, bool) {
 	iterateStore := prefix.NewStore(clientStore, []byte(KeyIterateConsensusStatePrefix))
 	iterator := iterateStore.Iterator(bigEndianHeightBytes(height), nil)
 	defer iterator.Close()
 	if !iterator.Valid() {
-		return nil, false
+		return nil, nil, false
 	}
 
 	// if iterator is at current height, ignore the consensus state at current height and get next height
@@ -281,35 +292,40 @@ func GetNextConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec
 	if bytes.Equal(iterator.Value(), host.ConsensusStateKey(height)) {
 		iterator.Next()
 		if !iterator.Valid() {
-			return nil, false
+			return nil, nil, false
 		}
 	}
 
-	csKey := iterator.Value()
-
-	return getTmConsensusState(clientStore, cdc, csKey)
+	consensusState, found := getTmConsensusState(clientStore, cdc, iterator.Value())
+	return getHeightFromBigEndianBytes(iterator.Key()), consensusState, found
 }
 
 // GetPreviousConsensusState returns the highest consensus state that is lower than the given height.
 // The Iterator returns a storetypes.Iterator which iterates from the end (exclusive) to start (inclusive).
 // Thus to get previous consensus state we call iterator.Value() immediately.
 func GetPreviousConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, height exported.Height) (*ConsensusState, bool) {
+	_, consensusState, found := getPreviousConsensusStateWithHeight(clientStore, cdc, height)
+	return consensusState, found
+}
+
+// getPreviousConsensusStateWithHeight returns the highest consensus state that is lower than the given height
+// together with the height it is stored at.
+func getPreviousConsensusStateWithHeight(clientStore storetypes.KVStore, cdc codec.BinaryCodec, height exported.Height) (exported.Height, *ConsensusState, bool) {
 	iterateStore := prefix.NewStore(clientStore, []byte(KeyIterateConsensusStatePrefix))
 	iterator := iterateStore.ReverseIterator(nil, bigEndianHeightBytes(height))
 	defer iterator.Close()
 
 	if !iterator.Valid() {
-		return nil, false
+		return nil, nil, false
 	}
 
-	csKey := iterator.Value()
-
-	return getTmConsensusState(clientStore, cdc, csKey)
+	consensusState, found := getTmConsensusState(clientStore, cdc, iterator.Value())
+	return getHeightFromBigEndianBytes(iterator.Key()), consensusState, found
 }
 
 // PruneAllExpiredConsensusStates iterates over all consensus states for a given
-// client store. If a consensus state is expired, it is deleted and its metadata
-// is deleted.
+// client store. If a consensus state is outside of the client's trusting period,
+// it is deleted and its metadata is deleted.
 func PruneAllExpiredConsensusStates(
 	ctx sdk.Context, clientStore storetypes.KVStore,
 	cdc codec.BinaryCodec, clientState *ClientState,
@@ -323,7 +339,7 @@ func PruneAllExpiredConsensusStates(
 			return true
 		}
 
-		if consState.IsExpired(ctx.BlockTime()) {
+		if clientState.IsExpired(consState.HeaderTimestamp.AsTime(), ctx.BlockTime()) {
 			heights = append(heights, height)
 		}
 
@@ -343,6 +359,47 @@ func PruneAllExpiredConsensusStates(
 	return nil
 }
 
+// pruneExpiredConsensusStates iterates over the consensus states in ascending height order and
+// deletes up to limit consensus states that are outside of the client's trusting period, along
+// with their metadata. Consensus state timestamps increase with height, so iteration stops at the
+// first consensus state that has not expired. The heights of the pruned consensus states are returned.
+func pruneExpiredConsensusStates(
+	ctx sdk.Context, clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec, clientState *ClientState, limit int,
+) []exported.Height {
+	var heights []exported.Height
+
+	pruneCb := func(height exported.Height) bool {
+		if len(heights) >= limit {
+			return true
+		}
+
+		consState, err := GetConsensusState(clientStore, cdc, height)
+		// this error should never occur
+		if err != nil {
+			return true
+		}
+
+		if !clientState.IsExpired(consState.HeaderTimestamp.AsTime(), ctx.BlockTime()) {
+			return true
+		}
+
+		heights = append(heights, height)
+		return false
+	}
+
+	// this error should never occur, the callback never returns an error
+	_ = IterateConsensusStateAscending(clientStore, pruneCb)
+
+	// consensus states are deleted after iteration to avoid mutating the store while iterating over it
+	for _, height := range heights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+
+	return heights
+}
+
 // Helper function for GetNextConsensusState and GetPreviousConsensusState
 func getTmConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, key []byte) (*ConsensusState, bool) {
 	bz := clientStore.Get(key)
@@ -0,0 +1,87 @@
+package groth16
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateStatePrunesExpiredConsensusStates(t *testing.T) {
+	// expiredStates returns n expired consensus states
+	expiredStates := func(n int) []bool {
+		expired := make([]bool, n)
+		for i := range expired {
+			expired[i] = true
+		}
+		return expired
+	}
+
+	testCases := []struct {
+		name string
+		// expired lists whether the consensus states stored in ascending height order from the initial
+		// consensus state onwards have expired
+		expired   []bool
+		expPruned int
+	}{
+		{"no expired consensus states", []bool{false, false}, 0},
+		{"expired consensus states are pruned", []bool{true, true, false}, 2},
+		{"pruning stops at the first consensus state that has not expired", []bool{true, false, true}, 1},
+		{"all expired consensus states are pruned", expiredStates(3), 3},
+		{"at most MaxPrunedConsensusStatesPerUpdate consensus states are pruned", expiredStates(MaxPrunedConsensusStatesPerUpdate + 5), MaxPrunedConsensusStatesPerUpdate},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t)
+			clientStore := c.clientStore()
+			trustingPeriod := c.clientState(t).TrustingPeriod.AsDuration()
+
+			var heights []exported.Height
+			for i, expired := range tc.expired {
+				height := clienttypes.NewHeight(0, uint64(testTrustedHeight+i))
+				timestamp := testGenesisTime.Add(time.Duration(i-len(tc.expired)) * time.Minute)
+				if expired {
+					timestamp = c.ctx.BlockTime().Add(-trustingPeriod - time.Hour + time.Duration(i)*time.Minute)
+				}
+				consensusState := NewConsensusState(timestamp, testStateRoot(height.RevisionHeight), testHeaderHash(height.RevisionHeight))
+				SetConsensusState(clientStore, c.cdc, consensusState, height)
+				setConsensusMetadata(c.ctx, clientStore, height)
+				heights = append(heights, height)
+			}
+
+			// the update builds on a consensus state above the test consensus states that has not expired
+			trustedHeight := uint64(testTrustedHeight + len(tc.expired))
+			trustedConsensusState := NewConsensusState(testGenesisTime, testStateRoot(trustedHeight), testHeaderHash(trustedHeight))
+			SetConsensusState(clientStore, c.cdc, trustedConsensusState, clienttypes.NewHeight(0, trustedHeight))
+			setConsensusMetadata(c.ctx, clientStore, clienttypes.NewHeight(0, trustedHeight))
+
+			c.update(t, newTestHeader(t, trustedHeight, trustedHeight+1, testGenesisTime.Add(time.Minute)))
+
+			for i, height := range heights {
+				_, err := GetConsensusState(clientStore, c.cdc, height)
+				_, foundTime := GetProcessedTime(clientStore, height)
+				_, foundHeight := GetProcessedHeight(clientStore, height)
+				iterationKey := GetIterationKey(clientStore, height)
+
+				if i < tc.expPruned {
+					require.ErrorIs(t, err, clienttypes.ErrConsensusStateNotFound, "height %s", height)
+					require.False(t, foundTime, "height %s", height)
+					require.False(t, foundHeight, "height %s", height)
+					require.Nil(t, iterationKey, "height %s", height)
+				} else {
+					require.NoError(t, err, "height %s", height)
+					require.True(t, foundTime, "height %s", height)
+					require.True(t, foundHeight, "height %s", height)
+					require.NotNil(t, iterationKey, "height %s", height)
+				}
+			}
+
+			// the trusted and the new consensus state have not expired
+			c.consensusState(t, trustedHeight)
+			c.consensusState(t, trustedHeight+1)
+		})
+	}
+}
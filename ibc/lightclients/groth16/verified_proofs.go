@@ -0,0 +1,197 @@
+package groth16
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// KeyVerifiedAccountProofPrefix is the transient store prefix of the ICS26Router account proofs
+	// verified within a transaction.
+	KeyVerifiedAccountProofPrefix = "verifiedAccountProofs/"
+	// KeyVerifiedStorageMultiProofPrefix is the transient store prefix of the storage multiproofs
+	// verified within a transaction and of the values of their storage slots.
+	KeyVerifiedStorageMultiProofPrefix = "verifiedStorageMultiProofs/"
+)
+
+const (
+	// absentStorageSlot and presentStorageSlot prefix the values of the storage slots of verified storage
+	// multiproofs in the transient store.
+	absentStorageSlot  byte = 0
+	presentStorageSlot byte = 1
+)
+
+// verifiedProofs records the ICS26Router account proofs and storage multiproofs verified within a
+// transaction, so that a batch proof attached to many messages of a transaction is only verified and
+// charged for once. The proofs are recorded in a transient store under the hash of the transaction
+// bytes. Writes go to the branch of the transient store of the transaction, so the records are discarded
+// together with a failed transaction, and a transaction never sees the proofs of another transaction.
+// Whether a proof is charged for therefore only depends on the transaction, and simulating a
+// transaction consumes the same gas as executing it.
+//
+// The transient store is accessed without gas metering, the gas of a proof is charged when it is
+// verified.
+type verifiedProofs struct {
+	storeKey storetypes.StoreKey
+}
+
+// verifyAccountProof verifies the account proof of the ICS26Router contract unless the same account
+// proof has already been verified against the state root within the current transaction. Account
+// proofs are always verified outside of a transaction.
+func (p verifiedProofs) verifyAccountProof(ctx sdktypes.Context, stateRoot []byte, routerAddress common.Address, proof *MptProof) error {
+	store, txHash, ok := p.txStore(ctx)
+	if !ok {
+		return verifyAccountProof(ctx.GasMeter(), stateRoot, routerAddress, proof)
+	}
+
+	proofKey, err := accountProofKey(stateRoot, routerAddress, proof)
+	if err != nil {
+		return err
+	}
+	key := verifiedProofKey(KeyVerifiedAccountProofPrefix, txHash, proofKey)
+	if store.Has(key) {
+		return nil
+	}
+
+	if err := verifyAccountProof(ctx.GasMeter(), stateRoot, routerAddress, proof); err != nil {
+		return err
+	}
+
+	store.Set(key, []byte{1})
+	return nil
+}
+
+// storageMultiProofValue returns the trie value of the storage slot proven by the storage multiproof of
+// the proof, which is nil if the slot is absent. The multiproof is verified and charged for once per
+// transaction, later lookups of any of its storage slots within the transaction only read the recorded
+// values. Multiproofs are always verified outside of a transaction. The account proof must have been
+// verified beforehand.
+func (p verifiedProofs) storageMultiProofValue(ctx sdktypes.Context, proof *MptProof, storageKey common.Hash) ([]byte, error) {
+	store, txHash, ok := p.txStore(ctx)
+	if !ok {
+		values, err := verifyStorageMultiProof(ctx.GasMeter(), proof)
+		if err != nil {
+			return nil, err
+		}
+		return storageSlotValue(values, storageKey)
+	}
+
+	proofKey, err := storageMultiProofKey(proof)
+	if err != nil {
+		return nil, err
+	}
+	key := verifiedProofKey(KeyVerifiedStorageMultiProofPrefix, txHash, proofKey)
+
+	if !store.Has(key) {
+		values, err := verifyStorageMultiProof(ctx.GasMeter(), proof)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, storageProof := range proof.StorageProofs {
+			slot := common.BytesToHash(storageProof.Key)
+			value := values[slot]
+			if value == nil {
+				store.Set(storageSlotKey(key, slot), []byte{absentStorageSlot})
+			} else {
+				store.Set(storageSlotKey(key, slot), append([]byte{presentStorageSlot}, value...))
+			}
+		}
+		store.Set(key, []byte{1})
+
+		return storageSlotValue(values, storageKey)
+	}
+
+	bz := store.Get(storageSlotKey(key, storageKey))
+	switch {
+	case len(bz) == 0:
+		return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "proof does not contain storage key %s", storageKey)
+	case bz[0] == absentStorageSlot:
+		return nil, nil
+	default:
+		return bz[1:], nil
+	}
+}
+
+// txStore returns the transient store of the verified proofs and the hash of the transaction bytes.
+// False is returned outside of a transaction.
+func (p verifiedProofs) txStore(ctx sdktypes.Context) (storetypes.KVStore, [sha256.Size]byte, bool) {
+	if p.storeKey == nil || len(ctx.TxBytes()) == 0 {
+		return nil, [sha256.Size]byte{}, false
+	}
+
+	return ctx.MultiStore().GetKVStore(p.storeKey), sha256.Sum256(ctx.TxBytes()), true
+}
+
+// storageSlotValue returns the value of the storage slot of a verified storage multiproof.
+func storageSlotValue(values map[common.Hash][]byte, storageKey common.Hash) ([]byte, error) {
+	value, ok := values[storageKey]
+	if !ok {
+		return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "proof does not contain storage key %s", storageKey)
+	}
+	return value, nil
+}
+
+// verifiedProofKey returns the transient store key of a proof verified within the transaction.
+func verifiedProofKey(prefix string, txHash, proofKey [sha256.Size]byte) []byte {
+	key := make([]byte, 0, len(prefix)+len(txHash)+len(proofKey))
+	key = append(key, prefix...)
+	key = append(key, txHash[:]...)
+	return append(key, proofKey[:]...)
+}
+
+// storageSlotKey returns the transient store key of the value of a storage slot of a verified storage
+// multiproof.
+func storageSlotKey(multiProofKey []byte, slot common.Hash) []byte {
+	key := make([]byte, 0, len(multiProofKey)+common.HashLength)
+	key = append(key, multiProofKey...)
+	return append(key, slot.Bytes()...)
+}
+
+// accountProofKey returns the digest of the state root, the ICS26Router address and the account
+// proof with its claimed account state.
+func accountProofKey(stateRoot []byte, routerAddress common.Address, proof *MptProof) ([sha256.Size]byte, error) {
+	bz, err := rlp.EncodeToBytes([]any{
+		stateRoot,
+		routerAddress,
+		proof.Address,
+		proof.Nonce,
+		proof.Balance,
+		proof.StorageHash,
+		proof.CodeHash,
+		proof.AccountProof,
+	})
+	if err != nil {
+		return [sha256.Size]byte{}, fmt.Errorf("failed to encode account proof: %w", err)
+	}
+
+	return sha256.Sum256(bz), nil
+}
+
+// storageMultiProofKey returns the digest of the storage root, the storage slots and the storage nodes
+// of a storage multiproof. The claimed values of the storage slots are not part of the key as the values
+// are read from the verified trie.
+func storageMultiProofKey(proof *MptProof) ([sha256.Size]byte, error) {
+	slots := make([][]byte, len(proof.StorageProofs))
+	for i, storageProof := range proof.StorageProofs {
+		slots[i] = storageProof.Key
+	}
+
+	bz, err := rlp.EncodeToBytes([]any{
+		proof.StorageHash,
+		slots,
+		proof.StorageNodes,
+	})
+	if err != nil {
+		return [sha256.Size]byte{}, fmt.Errorf("failed to encode storage multiproof: %w", err)
+	}
+
+	return sha256.Sum256(bz), nil
+}
@@ -0,0 +1,118 @@
+package groth16
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/mpt"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyMembershipGasDeterministic(t *testing.T) {
+	const numPaths = 16
+
+	for _, multiProof := range []bool{false, true} {
+		t.Run(fmt.Sprintf("multiproof %t", multiProof), func(t *testing.T) {
+			c := newTestClient(t)
+			paths, values, proof := newTestRouterProof(t, c, numPaths, multiProof)
+			height := clienttypes.NewHeight(0, testTrustedHeight)
+
+			// runTx verifies the membership of all paths in a transaction and returns the gas consumed.
+			// The writes of the transaction are committed if write is set, as for a successful transaction
+			// in FinalizeBlock.
+			runTx := func(mode sdktypes.ExecMode, txBytes []byte, write bool) storetypes.Gas {
+				ctx := c.ctx.WithExecMode(mode).WithTxBytes(txBytes).WithGasMeter(storetypes.NewInfiniteGasMeter())
+				txCtx, writeCache := ctx.CacheContext()
+				for i := range paths {
+					require.NoError(t, c.module.VerifyMembership(txCtx, testClientID, height, 0, 0, proof, commitmenttypesv2.NewMerklePath(paths[i]), values[i]))
+				}
+				if write {
+					writeCache()
+				}
+				return ctx.GasMeter().GasConsumed()
+			}
+
+			// outside of a transaction every verification pays for the whole proof
+			ctx := c.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			require.NoError(t, c.module.VerifyMembership(ctx, testClientID, height, 0, 0, proof, commitmenttypesv2.NewMerklePath(paths[0]), values[0]))
+			uncachedGas := ctx.GasMeter().GasConsumed()
+
+			// simulating a transaction consumes the same gas as executing it, and the proofs shared by the
+			// messages of the transaction are only paid for once
+			simulateGas := runTx(sdktypes.ExecModeSimulate, []byte("tx 1"), false)
+			require.Less(t, simulateGas, numPaths*uncachedGas)
+			require.Equal(t, simulateGas, runTx(sdktypes.ExecModeFinalize, []byte("tx 1"), true))
+
+			// the next transaction of the block pays for the proofs again
+			require.Equal(t, simulateGas, runTx(sdktypes.ExecModeFinalize, []byte("tx 2"), true))
+		})
+	}
+}
+
+func TestStorageMultiProofVerifiedValues(t *testing.T) {
+	c := newTestClient(t)
+	paths, values, proof := newTestRouterProof(t, c, 2, true)
+	height := clienttypes.NewHeight(0, testTrustedHeight)
+	ctx := c.ctx.WithTxBytes([]byte("tx 1"))
+
+	require.NoError(t, c.module.VerifyMembership(ctx, testClientID, height, 0, 0, proof, commitmenttypesv2.NewMerklePath(paths[0]), values[0]))
+
+	// the recorded values are still checked against the expected values
+	err := c.module.VerifyMembership(ctx, testClientID, height, 0, 0, proof, commitmenttypesv2.NewMerklePath(paths[1]), values[0])
+	require.ErrorIs(t, err, commitmenttypes.ErrInvalidProof)
+	err = c.module.VerifyNonMembership(ctx, testClientID, height, 0, 0, proof, commitmenttypesv2.NewMerklePath(paths[1]))
+	require.ErrorIs(t, err, commitmenttypes.ErrInvalidProof)
+
+	// a storage slot that is not part of the verified multiproof is rejected
+	err = c.module.VerifyNonMembership(ctx, testClientID, height, 0, 0, proof, commitmenttypesv2.NewMerklePath([]byte("absent")))
+	require.ErrorIs(t, err, commitmenttypes.ErrInvalidProof)
+}
+
+// newTestRouterProof returns paths with their commitments and the encoded batch proof of the commitments,
+// whose storage slots are proven by a multiproof if multiProof is set. The state root of the initial
+// consensus state of the client is set to the state root of the proof.
+func newTestRouterProof(t *testing.T, c *testClient, numPaths int, multiProof bool) (paths [][]byte, values [][]byte, proof []byte) {
+	t.Helper()
+
+	storage := make(map[common.Hash]common.Hash)
+	var slots []common.Hash
+	for i := 0; i < numPaths; i++ {
+		path := []byte(fmt.Sprintf("commitments/%d", i))
+		value := crypto.Keccak256Hash(path)
+		slot := CommitmentStorageKey(path)
+
+		paths = append(paths, path)
+		values = append(values, value.Bytes())
+		storage[slot] = value
+		slots = append(slots, slot)
+	}
+
+	fixture, err := mpt.GenerateRouterFixture(testRouterAddress, storage, 8, slots...)
+	require.NoError(t, err)
+	c.setStateRoot(t, fixture.StateRoot)
+
+	bz, err := json.Marshal(fixture.Proof)
+	require.NoError(t, err)
+	var batchProof MptBatchProofJSON
+	require.NoError(t, json.Unmarshal(bz, &batchProof))
+	mptProof, err := batchProof.ToProto()
+	require.NoError(t, err)
+
+	if multiProof {
+		mptProof, err = MergeMptProofs(mptProof)
+		require.NoError(t, err)
+		require.True(t, mptProof.isMultiProof())
+	}
+
+	proof, err = EncodeMptProof(mptProof)
+	require.NoError(t, err)
+	return paths, values, proof
+}
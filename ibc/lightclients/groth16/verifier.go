@@ -0,0 +1,243 @@
+package groth16
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+/*
+This file contains a Go port of the SP1 Groth16 verifier found in the sp1-verifier crate
+(Groth16Verifier::verify). It is used by the light client to verify state transition proofs
+deterministically inside the state machine.
+
+An SP1 Groth16 proof is encoded as:
+  - 4 bytes: the first 4 bytes of sha256(groth16Vk). This binds the proof to the gnark verifying key.
+  - 256 bytes: the uncompressed proof points Ar (G1), Bs (G2) and Krs (G1).
+
+The verifying key uses gnark's compressed serialization format where only the elements needed for
+verification are read: alpha (G1), beta, gamma and delta (G2) and the public input commitments K (G1).
+
+The proof has two public inputs:
+ 1. the SP1 program verifier key hash.
+ 2. the sha256 digest of the SP1 public values with the 3 most significant bits masked off so that it
+    fits in the BN254 scalar field.
+*/
+
+const (
+	// groth16VkHashPrefixLength is the length of the verifying key hash prefix on an SP1 Groth16 proof.
+	groth16VkHashPrefixLength = 4
+	// groth16ProofLength is the length of the uncompressed Ar, Bs and Krs proof points.
+	groth16ProofLength = 2*bn254.SizeOfG1AffineUncompressed + bn254.SizeOfG2AffineUncompressed
+)
+
+// groth16VerifyingKey contains the elements of a gnark Groth16 verifying key that are needed for
+// proof verification.
+type groth16VerifyingKey struct {
+	alpha bn254.G1Affine
+	beta  bn254.G2Affine
+	gamma bn254.G2Affine
+	delta bn254.G2Affine
+	k     []bn254.G1Affine
+}
+
+// groth16Proof contains the elements of a Groth16 proof.
+type groth16Proof struct {
+	ar  bn254.G1Affine
+	bs  bn254.G2Affine
+	krs bn254.G1Affine
+}
+
+// VerifySP1Groth16Proof verifies an SP1 Groth16 proof for the given public values against the SP1
+// program verifier key hash (hex encoded) and the gnark serialized Groth16 verifying key.
+func VerifySP1Groth16Proof(proof []byte, publicValues []byte, vkeyHash string, groth16Vk []byte) error {
+	if len(proof) != groth16VkHashPrefixLength+groth16ProofLength {
+		return sdkerrors.Wrapf(ErrInvalidStateTransitionProof, "expected proof length %d, got %d", groth16VkHashPrefixLength+groth16ProofLength, len(proof))
+	}
+
+	// check that the proof was generated by the proving key corresponding to the given verifying key
+	groth16VkHash := sha256.Sum256(groth16Vk)
+	if !bytes.Equal(groth16VkHash[:groth16VkHashPrefixLength], proof[:groth16VkHashPrefixLength]) {
+		return sdkerrors.Wrapf(ErrInvalidStateTransitionProof, "groth16 vk hash mismatch: proof %X, vk %X", proof[:groth16VkHashPrefixLength], groth16VkHash[:groth16VkHashPrefixLength])
+	}
+
+	programVkey, err := decodeVkeyHash(vkeyHash)
+	if err != nil {
+		return err
+	}
+
+	vk, err := parseGroth16VerifyingKey(groth16Vk)
+	if err != nil {
+		return err
+	}
+
+	groth16Proof, err := parseGroth16Proof(proof[groth16VkHashPrefixLength:])
+	if err != nil {
+		return err
+	}
+
+	publicValuesDigest := hashPublicValues(publicValues)
+	publicInputs := make([]fr.Element, 2)
+	if err := publicInputs[0].SetBytesCanonical(programVkey[:]); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidVerifierKey, "verifier key hash is not a valid field element: %v", err)
+	}
+	if err := publicInputs[1].SetBytesCanonical(publicValuesDigest[:]); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidStateTransitionProof, "public values digest is not a valid field element: %v", err)
+	}
+
+	return verifyGroth16(vk, groth16Proof, publicInputs)
+}
+
+// verifyGroth16 performs the Groth16 pairing check:
+// e(-Ar, Bs) * e(vk_x, gamma) * e(Krs, delta) * e(alpha, beta) == 1
+// where vk_x = K[0] + sum(publicInputs[i] * K[i+1]).
+func verifyGroth16(vk *groth16VerifyingKey, proof *groth16Proof, publicInputs []fr.Element) error {
+	if len(vk.k) != len(publicInputs)+1 {
+		return sdkerrors.Wrapf(ErrInvalidVerifierKey, "expected %d public input commitments, got %d", len(publicInputs)+1, len(vk.k))
+	}
+
+	preparedInputs := vk.k[0]
+	for i, input := range publicInputs {
+		var term bn254.G1Affine
+		term.ScalarMultiplication(&vk.k[i+1], input.BigInt(new(big.Int)))
+		preparedInputs.Add(&preparedInputs, &term)
+	}
+
+	var negAr bn254.G1Affine
+	negAr.Neg(&proof.ar)
+
+	ok, err := bn254.PairingCheck(
+		[]bn254.G1Affine{negAr, preparedInputs, proof.krs, vk.alpha},
+		[]bn254.G2Affine{proof.bs, vk.gamma, vk.delta, vk.beta},
+	)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidStateTransitionProof, "pairing check failed: %v", err)
+	}
+	if !ok {
+		return sdkerrors.Wrap(ErrInvalidStateTransitionProof, "pairing check failed")
+	}
+
+	return nil
+}
+
+// parseGroth16Proof decodes the uncompressed Ar, Bs and Krs proof points.
+func parseGroth16Proof(bz []byte) (*groth16Proof, error) {
+	if len(bz) != groth16ProofLength {
+		return nil, sdkerrors.Wrapf(ErrInvalidStateTransitionProof, "expected proof length %d, got %d", groth16ProofLength, len(bz))
+	}
+
+	var proof groth16Proof
+	offset := 0
+	if _, err := proof.ar.SetBytes(bz[offset : offset+bn254.SizeOfG1AffineUncompressed]); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidStateTransitionProof, "invalid Ar: %v", err)
+	}
+	offset += bn254.SizeOfG1AffineUncompressed
+	if _, err := proof.bs.SetBytes(bz[offset : offset+bn254.SizeOfG2AffineUncompressed]); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidStateTransitionProof, "invalid Bs: %v", err)
+	}
+	offset += bn254.SizeOfG2AffineUncompressed
+	if _, err := proof.krs.SetBytes(bz[offset : offset+bn254.SizeOfG1AffineUncompressed]); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidStateTransitionProof, "invalid Krs: %v", err)
+	}
+
+	return &proof, nil
+}
+
+// parseGroth16VerifyingKey decodes a gnark compressed Groth16 verifying key. The layout is:
+// alpha (G1) | beta (G1) | beta (G2) | gamma (G2) | delta (G1) | delta (G2) | len(K) (uint32) | K (G1)...
+// The G1 representations of beta and delta are not needed for verification and are skipped.
+func parseGroth16VerifyingKey(bz []byte) (*groth16VerifyingKey, error) {
+	const (
+		g1Size = bn254.SizeOfG1AffineCompressed
+		g2Size = bn254.SizeOfG2AffineCompressed
+	)
+
+	var (
+		vk     groth16VerifyingKey
+		offset int
+	)
+
+	readG1 := func(p *bn254.G1Affine, name string) error {
+		if len(bz) < offset+g1Size {
+			return sdkerrors.Wrapf(ErrInvalidVerifierKey, "groth16 vk too short to read %s", name)
+		}
+		if _, err := p.SetBytes(bz[offset : offset+g1Size]); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidVerifierKey, "invalid %s: %v", name, err)
+		}
+		offset += g1Size
+		return nil
+	}
+	readG2 := func(p *bn254.G2Affine, name string) error {
+		if len(bz) < offset+g2Size {
+			return sdkerrors.Wrapf(ErrInvalidVerifierKey, "groth16 vk too short to read %s", name)
+		}
+		if _, err := p.SetBytes(bz[offset : offset+g2Size]); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidVerifierKey, "invalid %s: %v", name, err)
+		}
+		offset += g2Size
+		return nil
+	}
+
+	if err := readG1(&vk.alpha, "alpha"); err != nil {
+		return nil, err
+	}
+	offset += g1Size // skip beta G1
+	if err := readG2(&vk.beta, "beta"); err != nil {
+		return nil, err
+	}
+	if err := readG2(&vk.gamma, "gamma"); err != nil {
+		return nil, err
+	}
+	offset += g1Size // skip delta G1
+	if err := readG2(&vk.delta, "delta"); err != nil {
+		return nil, err
+	}
+
+	if len(bz) < offset+4 {
+		return nil, sdkerrors.Wrap(ErrInvalidVerifierKey, "groth16 vk too short to read number of public input commitments")
+	}
+	numK := binary.BigEndian.Uint32(bz[offset : offset+4])
+	offset += 4
+	if uint64(len(bz)) < uint64(offset)+uint64(numK)*g1Size {
+		return nil, sdkerrors.Wrapf(ErrInvalidVerifierKey, "groth16 vk too short to read %d public input commitments", numK)
+	}
+
+	vk.k = make([]bn254.G1Affine, numK)
+	for i := range vk.k {
+		if err := readG1(&vk.k[i], fmt.Sprintf("K[%d]", i)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &vk, nil
+}
+
+// decodeVkeyHash decodes a hex encoded SP1 program verifier key hash with an optional 0x prefix.
+func decodeVkeyHash(vkeyHash string) ([32]byte, error) {
+	var vkey [32]byte
+	bz, err := hex.DecodeString(strings.TrimPrefix(vkeyHash, "0x"))
+	if err != nil {
+		return vkey, sdkerrors.Wrapf(ErrInvalidVerifierKey, "failed to decode verifier key hash: %v", err)
+	}
+	if len(bz) != len(vkey) {
+		return vkey, sdkerrors.Wrapf(ErrInvalidVerifierKey, "expected verifier key hash length %d, got %d", len(vkey), len(bz))
+	}
+	copy(vkey[:], bz)
+	return vkey, nil
+}
+
+// hashPublicValues returns the sha256 digest of the SP1 public values with the 3 most significant
+// bits set to zero so that the digest is a valid BN254 scalar field element.
+func hashPublicValues(publicValues []byte) [32]byte {
+	digest := sha256.Sum256(publicValues)
+	digest[0] &= 0x1F
+	return digest
+}
@@ -0,0 +1,266 @@
+package groth16
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// sp1FixturePath is the path of an SP1 v4 Groth16 proof fixture in the format written by the SP1
+	// project template: the program vkey hash, the public values and the proof bytes.
+	sp1FixturePath = filepath.Join("testdata", "sp1_groth16_fixture.json")
+	// sp1Groth16VkPath is the path of the groth16_vk.bin of the SP1 v4 circuits the fixture is proven with.
+	sp1Groth16VkPath = filepath.Join("testdata", "groth16_vk.bin")
+)
+
+// groth16Fixture is an SP1 Groth16 proof of some public values together with the verifier key hash and
+// the gnark serialized verifying key it verifies against.
+type groth16Fixture struct {
+	VkeyHash     string
+	PublicValues []byte
+	Proof        []byte
+	Groth16Vk    []byte
+}
+
+// sp1ProofFixture is the JSON encoding of a proof fixture written by the SP1 project template.
+type sp1ProofFixture struct {
+	Vkey         string        `json:"vkey"`
+	PublicValues hexutil.Bytes `json:"publicValues"`
+	Proof        hexutil.Bytes `json:"proof"`
+}
+
+// TestVerifySP1Groth16Proof checks the rejection paths of the verifier against a synthetic proof. The
+// synthetic proof is encoded by newGroth16Fixture, so the test does not show that the encodings match SP1,
+// which is covered by TestVerifySP1Groth16ProofKnownAnswer.
+func TestVerifySP1Groth16Proof(t *testing.T) {
+	fixture := newGroth16Fixture(t, testVkeyHash, testPublicValues())
+
+	truncatedProof := fixture.Proof[:len(fixture.Proof)-1]
+
+	flippedPublicValues := append([]byte(nil), fixture.PublicValues...)
+	flippedPublicValues[len(flippedPublicValues)-1] ^= 1
+
+	wrongVkeyHash := fixture.VkeyHash[:len(fixture.VkeyHash)-2] + "00"
+
+	tamperedVk := append([]byte(nil), fixture.Groth16Vk...)
+	tamperedVk[len(tamperedVk)-1] ^= 1
+
+	testCases := []struct {
+		name         string
+		proof        []byte
+		publicValues []byte
+		vkeyHash     string
+		groth16Vk    []byte
+		expErr       error
+	}{
+		{"success", fixture.Proof, fixture.PublicValues, fixture.VkeyHash, fixture.Groth16Vk, nil},
+		{"flipped public value byte", fixture.Proof, flippedPublicValues, fixture.VkeyHash, fixture.Groth16Vk, ErrInvalidStateTransitionProof},
+		{"wrong vkey hash", fixture.Proof, fixture.PublicValues, wrongVkeyHash, fixture.Groth16Vk, ErrInvalidStateTransitionProof},
+		{"truncated proof", truncatedProof, fixture.PublicValues, fixture.VkeyHash, fixture.Groth16Vk, ErrInvalidStateTransitionProof},
+		{"proof without vk hash prefix", fixture.Proof[groth16VkHashPrefixLength:], fixture.PublicValues, fixture.VkeyHash, fixture.Groth16Vk, ErrInvalidStateTransitionProof},
+		{"groth16 vk does not match proof", fixture.Proof, fixture.PublicValues, fixture.VkeyHash, tamperedVk, ErrInvalidStateTransitionProof},
+		{"malformed vkey hash", fixture.Proof, fixture.PublicValues, "0x1234", fixture.Groth16Vk, ErrInvalidVerifierKey},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifySP1Groth16Proof(tc.proof, tc.publicValues, tc.vkeyHash, tc.groth16Vk)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestGetStateTransitionVerifier(t *testing.T) {
+	testCases := []struct {
+		name        string
+		proofSystem ProofSystem
+		mock        bool
+		expErr      error
+	}{
+		{"sp1 groth16", ProofSystem_PROOF_SYSTEM_SP1_GROTH16, false, nil},
+		{"mock with the groth16mock build tag", ProofSystem_PROOF_SYSTEM_MOCK, true, nil},
+		{"mock without the groth16mock build tag", ProofSystem_PROOF_SYSTEM_MOCK, false, ErrInvalidProofSystem},
+		{"sp1 plonk", ProofSystem_PROOF_SYSTEM_SP1_PLONK, false, ErrInvalidProofSystem},
+		{"unknown proof system", ProofSystem(100), false, ErrInvalidProofSystem},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setMockProofSystem(t, tc.mock)
+
+			_, err := GetStateTransitionVerifier(tc.proofSystem)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestVerifySP1Groth16ProofKnownAnswer verifies a proof generated by SP1 v4 against the groth16_vk.bin of
+// the SP1 v4 circuits. It checks the proof layout, the verifying key encoding and the public values digest
+// against SP1 itself. The test is skipped until the fixture is checked into testdata.
+func TestVerifySP1Groth16ProofKnownAnswer(t *testing.T) {
+	fixture, ok := loadSP1Fixture(t)
+	if !ok {
+		t.Skipf("no SP1 v4 Groth16 fixture at %s and %s", sp1FixturePath, sp1Groth16VkPath)
+	}
+
+	require.NoError(t, VerifySP1Groth16Proof(fixture.Proof, fixture.PublicValues, fixture.VkeyHash, fixture.Groth16Vk))
+
+	flippedPublicValues := append([]byte(nil), fixture.PublicValues...)
+	flippedPublicValues[len(flippedPublicValues)-1] ^= 1
+	err := VerifySP1Groth16Proof(fixture.Proof, flippedPublicValues, fixture.VkeyHash, fixture.Groth16Vk)
+	require.ErrorIs(t, err, ErrInvalidStateTransitionProof)
+
+	err = VerifySP1Groth16Proof(fixture.Proof, fixture.PublicValues, testVkeyHash, fixture.Groth16Vk)
+	require.ErrorIs(t, err, ErrInvalidStateTransitionProof)
+}
+
+// loadSP1Fixture reads the SP1 v4 Groth16 fixture and verifying key from testdata. It returns false if
+// neither of them has been checked in.
+func loadSP1Fixture(t *testing.T) (*groth16Fixture, bool) {
+	t.Helper()
+
+	bz, err := os.ReadFile(sp1FixturePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		_, err := os.Stat(sp1Groth16VkPath)
+		require.ErrorIs(t, err, fs.ErrNotExist, "%s is checked in without %s", sp1Groth16VkPath, sp1FixturePath)
+		return nil, false
+	}
+	require.NoError(t, err)
+
+	var fixture sp1ProofFixture
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+
+	groth16Vk, err := os.ReadFile(sp1Groth16VkPath)
+	require.NoError(t, err)
+
+	return &groth16Fixture{
+		VkeyHash:     fixture.Vkey,
+		PublicValues: fixture.PublicValues,
+		Proof:        fixture.Proof,
+		Groth16Vk:    groth16Vk,
+	}, true
+}
+
+// testVkeyHash is the SP1 program verifier key hash of the synthetic Groth16 fixture.
+const testVkeyHash = "0x00a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f"
+
+// testPublicValues returns the encoded aggregator output proven by the synthetic Groth16 fixture.
+func testPublicValues() []byte {
+	return encodePublicValues(newTestOutput(testTrustedHeight, testTrustedHeight+1))
+}
+
+// newGroth16Fixture creates a Groth16 verifying key with a known trapdoor and forges a proof of the
+// public inputs of an SP1 Groth16 proof of the public values for the verifier key hash. The pairing check
+// holds by construction: with the trapdoor, C is chosen such that a·b = α·β + x·γ + c·δ, where x is the
+// discrete log of vk_x. The proof and verifying key are encoded the way the verifier decodes them, so the
+// fixture only shows that the verifier is consistent with itself.
+func newGroth16Fixture(t testing.TB, vkeyHash string, publicValues []byte) *groth16Fixture {
+	t.Helper()
+
+	programVkey, err := decodeVkeyHash(vkeyHash)
+	require.NoError(t, err)
+	publicValuesDigest := hashPublicValues(publicValues)
+
+	var inputs [2]fr.Element
+	require.NoError(t, inputs[0].SetBytesCanonical(programVkey[:]))
+	require.NoError(t, inputs[1].SetBytesCanonical(publicValuesDigest[:]))
+
+	alpha, beta, gamma, delta := trapdoor("alpha"), trapdoor("beta"), trapdoor("gamma"), trapdoor("delta")
+	k := []fr.Element{trapdoor("k0"), trapdoor("k1"), trapdoor("k2")}
+	a, b := trapdoor("a"), trapdoor("b")
+
+	// x = k0 + sum(inputs[i] * k[i+1])
+	x := k[0]
+	for i := range inputs {
+		var term fr.Element
+		term.Mul(&inputs[i], &k[i+1])
+		x.Add(&x, &term)
+	}
+
+	// c = (a·b - α·β - x·γ) / δ
+	var c, term fr.Element
+	c.Mul(&a, &b)
+	term.Mul(&alpha, &beta)
+	c.Sub(&c, &term)
+	term.Mul(&x, &gamma)
+	c.Sub(&c, &term)
+	term.Inverse(&delta)
+	c.Mul(&c, &term)
+
+	var vk []byte
+	vk = append(vk, g1Bytes(alpha)...)
+	vk = append(vk, g1Bytes(beta)...)
+	vk = append(vk, g2Bytes(beta)...)
+	vk = append(vk, g2Bytes(gamma)...)
+	vk = append(vk, g1Bytes(delta)...)
+	vk = append(vk, g2Bytes(delta)...)
+	vk = binary.BigEndian.AppendUint32(vk, uint32(len(k)))
+	for i := range k {
+		vk = append(vk, g1Bytes(k[i])...)
+	}
+
+	vkHash := sha256.Sum256(vk)
+	ar, bs, krs := g1(a).RawBytes(), g2(b).RawBytes(), g1(c).RawBytes()
+	var proof []byte
+	proof = append(proof, vkHash[:groth16VkHashPrefixLength]...)
+	proof = append(proof, ar[:]...)
+	proof = append(proof, bs[:]...)
+	proof = append(proof, krs[:]...)
+
+	return &groth16Fixture{
+		VkeyHash:     vkeyHash,
+		PublicValues: publicValues,
+		Proof:        proof,
+		Groth16Vk:    vk,
+	}
+}
+
+// trapdoor derives a deterministic scalar from the label.
+func trapdoor(label string) fr.Element {
+	var e fr.Element
+	e.SetBytes(sha256Bytes("groth16 fixture trapdoor " + label))
+	return e
+}
+
+func g1(s fr.Element) *bn254.G1Affine {
+	return new(bn254.G1Affine).ScalarMultiplicationBase(s.BigInt(new(big.Int)))
+}
+
+func g2(s fr.Element) *bn254.G2Affine {
+	return new(bn254.G2Affine).ScalarMultiplicationBase(s.BigInt(new(big.Int)))
+}
+
+func g1Bytes(s fr.Element) []byte {
+	bz := g1(s).Bytes()
+	return bz[:]
+}
+
+func g2Bytes(s fr.Element) []byte {
+	bz := g2(s).Bytes()
+	return bz[:]
+}
+
+func sha256Bytes(s string) []byte {
+	digest := sha256.Sum256([]byte(s))
+	return digest[:]
+}
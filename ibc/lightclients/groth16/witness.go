@@ -1,13 +1,22 @@
 package groth16
 
+import (
+	"encoding/binary"
+	"fmt"
+)
+
 // BlevmAggOutput is the output of the Blevm proof aggregator which gets used as a public
-// witness for proof verification. 
+// witness for proof verification.
 // Ref: https://github.com/celestiaorg/celestia-zkevm-ibc-demo/blob/4c43989012340c400d525751c32ef1a2d7762e8f/provers/blevm/common/src/lib.rs#L16
 type BlevmAggOutput struct {
 	// newest_header_hash is the last block's hash on the EVM roll-up
 	NewestHeaderHash [32]byte
 	// oldest_header_hash is the earliest block's hash on the EVM roll-up
 	OldestHeaderHash [32]byte
+	// trusted_header_hash is the hash of the parent of the earliest block on
+	// the EVM roll-up, it must match the header hash of the trusted consensus
+	// state
+	TrustedHeaderHash [32]byte
 	// celestia_header_hashes is the range of Celestia blocks that include all
 	// of the blob data the EVM roll-up has posted from oldest_header_hash to
 	// newest_header_hash
@@ -18,3 +27,52 @@ type BlevmAggOutput struct {
 	// newest_height is the most recent block number of the EVM roll-up
 	NewestHeight uint64
 }
+
+const (
+	// hashLength is the length of the hashes and state roots in BlevmAggOutput.
+	hashLength = 32
+	// uint64Length is the length of a bincode serialized u64.
+	uint64Length = 8
+)
+
+// DecodePublicValues decodes the public values from the Blevm proof aggregator.
+// The public values are encoded using bincode serialization. They're encoded in fixed order.
+// All of the fields are fixed size bytes32 and are encoded in little endian format.
+// Celestia header hashes is not a fixed size array therefore it is encoded
+// as a u64 length prefix(bytes8) followed by the hashes.
+func DecodePublicValues(data []byte) (*BlevmAggOutput, error) {
+	output := &BlevmAggOutput{}
+
+	// newest, oldest and trusted header hashes and the celestia header hashes length prefix
+	offset := 3*hashLength + uint64Length
+	if len(data) < offset {
+		return nil, fmt.Errorf("public values too short: expected at least %d bytes, got %d", offset, len(data))
+	}
+	copy(output.NewestHeaderHash[:], data[:hashLength])
+	copy(output.OldestHeaderHash[:], data[hashLength:2*hashLength])
+	copy(output.TrustedHeaderHash[:], data[2*hashLength:3*hashLength])
+
+	// Celestia header hashes are of variable length but bincode serialization
+	// prefixes them with a u64(8 bytes) length.
+	celestiaHeaderHashesLength := binary.LittleEndian.Uint64(data[3*hashLength : offset])
+	if celestiaHeaderHashesLength > uint64(len(data))/hashLength {
+		return nil, fmt.Errorf("public values length %d is too short for %d celestia header hashes", len(data), celestiaHeaderHashesLength)
+	}
+	expectedLength := uint64(offset) + celestiaHeaderHashesLength*hashLength + hashLength + uint64Length
+	if uint64(len(data)) != expectedLength {
+		return nil, fmt.Errorf("public values length %d does not match expected length %d", len(data), expectedLength)
+	}
+
+	output.CelestiaHeaderHashes = make([][]byte, celestiaHeaderHashesLength)
+	for i := range output.CelestiaHeaderHashes {
+		output.CelestiaHeaderHashes[i] = data[offset : offset+hashLength]
+		offset += hashLength
+	}
+
+	// Read remaining fixed size fields
+	copy(output.NewestStateRoot[:], data[offset:offset+hashLength])
+	offset += hashLength
+	output.NewestHeight = binary.LittleEndian.Uint64(data[offset : offset+uint64Length])
+
+	return output, nil
+}
@@ -0,0 +1,103 @@
+// Command mpt-fixture writes a JSON fixture with an EIP-1186 proof of ICS26Router commitments. The
+// proof is generated from a local state trie holding a synthetic ICS26Router account, so groth16 light
+// client tests can cover membership and non-membership without an execution client.
+//
+// Usage:
+//
+//	mpt-fixture -out fixture.json -commitment <hex path>=<hex value> -absent <hex path>
+//
+// The commitment and absent flags can be repeated. The storage proofs of the fixture are in the order of
+// the flags, commitments before absent paths, and the paths of the proven storage slots are listed in the
+// same order.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/lightclients/groth16"
+	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/mpt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// defaultRouterAddress is the ICS26Router address of the fixture unless -router is set.
+const defaultRouterAddress = "0xe53275a1fca119e1c5eeb32e7a72e54835a63936"
+
+// commitmentFixture is the fixture written by mpt-fixture.
+type commitmentFixture struct {
+	*mpt.Fixture
+	// Paths are the IBC paths of the proven storage slots in the order of the storage proofs.
+	Paths []hexutil.Bytes `json:"paths"`
+}
+
+func main() {
+	var (
+		out            = flag.String("out", "", "path of the fixture file, the fixture is written to stdout if empty")
+		router         = flag.String("router", defaultRouterAddress, "address of the ICS26Router account")
+		fillerAccounts = flag.Int("filler-accounts", 8, "number of unrelated accounts in the state trie")
+		paths          []hexutil.Bytes
+		absentPaths    []hexutil.Bytes
+		storage        = make(map[common.Hash]common.Hash)
+	)
+	flag.Func("commitment", "hex encoded IBC path and 32 byte commitment as <path>=<value>", func(s string) error {
+		path, value, ok := strings.Cut(s, "=")
+		if !ok {
+			return fmt.Errorf("expected <path>=<value>, got %q", s)
+		}
+		pathBz, err := hexutil.Decode(path)
+		if err != nil {
+			return fmt.Errorf("invalid path %q: %w", path, err)
+		}
+		valueBz, err := hexutil.Decode(value)
+		if err != nil || len(valueBz) != common.HashLength {
+			return fmt.Errorf("invalid commitment %q: expected %d hex encoded bytes", value, common.HashLength)
+		}
+
+		paths = append(paths, pathBz)
+		storage[groth16.CommitmentStorageKey(pathBz)] = common.BytesToHash(valueBz)
+		return nil
+	})
+	flag.Func("absent", "hex encoded IBC path without a commitment", func(s string) error {
+		path, err := hexutil.Decode(s)
+		if err != nil {
+			return fmt.Errorf("invalid path %q: %w", s, err)
+		}
+		absentPaths = append(absentPaths, path)
+		return nil
+	})
+	flag.Parse()
+
+	if !common.IsHexAddress(*router) {
+		log.Fatalf("invalid router address %q", *router)
+	}
+
+	paths = append(paths, absentPaths...)
+	slots := make([]common.Hash, len(paths))
+	for i, path := range paths {
+		slots[i] = groth16.CommitmentStorageKey(path)
+	}
+
+	fixture, err := mpt.GenerateRouterFixture(common.HexToAddress(*router), storage, *fillerAccounts, slots...)
+	if err != nil {
+		log.Fatalf("failed to generate fixture: %v", err)
+	}
+
+	bz, err := json.MarshalIndent(commitmentFixture{Fixture: fixture, Paths: paths}, "", "  ")
+	if err != nil {
+		log.Fatalf("failed to encode fixture: %v", err)
+	}
+	bz = append(bz, '\n')
+
+	if *out == "" {
+		_, _ = os.Stdout.Write(bz)
+		return
+	}
+	if err := os.WriteFile(*out, bz, 0o600); err != nil {
+		log.Fatalf("failed to write fixture: %v", err)
+	}
+}
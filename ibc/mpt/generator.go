@@ -0,0 +1,250 @@
+package mpt
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethtrie "github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb/database"
+	"github.com/holiman/uint256"
+)
+
+/*
+This file contains a generator of EIP-1186 proofs from a local EVM world state. It builds the state and
+storage tries in memory, so proofs of a synthetic ICS26Router account can be produced without a running
+execution client, e.g. to generate light client test fixtures with cmd/mpt-fixture.
+*/
+
+// RouterCodeHash is the code hash of the synthetic ICS26Router account of GenerateRouterFixture.
+var RouterCodeHash = crypto.Keccak256Hash([]byte("ICS26Router"))
+
+// AccountResult is the EIP-1186 eth_getProof response of an account and some of its storage slots.
+// Its JSON encoding is accepted as an MPT proof by the groth16 light client.
+// Ref: https://eips.ethereum.org/EIPS/eip-1186
+type AccountResult struct {
+	AccountProof []hexutil.Bytes `json:"accountProof"`
+	Address      common.Address  `json:"address"`
+	Balance      *hexutil.Big    `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []StorageResult `json:"storageProof"`
+}
+
+// StorageResult is the proof of a single storage slot of an AccountResult.
+type StorageResult struct {
+	Key   common.Hash     `json:"key"`
+	Value *hexutil.Big    `json:"value"`
+	Proof []hexutil.Bytes `json:"proof"`
+}
+
+// Fixture is an MPT proof of storage slots of the ICS26Router contract together with the state root
+// it is verified against.
+type Fixture struct {
+	StateRoot common.Hash    `json:"stateRoot"`
+	Proof     *AccountResult `json:"proof"`
+}
+
+// GenerateRouterFixture builds a state trie with a synthetic ICS26Router account at the router address
+// holding the given storage and fillerAccounts unrelated accounts, so that the account proof has inner
+// nodes. It returns the proof of the proven slots, which may include slots that are absent from the
+// storage to produce non-membership proofs.
+func GenerateRouterFixture(routerAddress common.Address, storage map[common.Hash]common.Hash, fillerAccounts int, provenSlots ...common.Hash) (*Fixture, error) {
+	state := NewState()
+	state.SetAccount(routerAddress, 1, new(uint256.Int), RouterCodeHash)
+	for slot, value := range storage {
+		state.SetStorage(routerAddress, slot, value)
+	}
+	for i := 0; i < fillerAccounts; i++ {
+		address := common.BytesToAddress(crypto.Keccak256([]byte(fmt.Sprintf("filler account %d", i))))
+		state.SetAccount(address, uint64(i), uint256.NewInt(uint64(i)), types.EmptyCodeHash)
+	}
+
+	stateRoot, err := state.Root()
+	if err != nil {
+		return nil, err
+	}
+	proof, err := state.GetProof(routerAddress, provenSlots...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Fixture{
+		StateRoot: stateRoot,
+		Proof:     proof,
+	}, nil
+}
+
+// State is an in-memory EVM world state that generates EIP-1186 proofs of its accounts and storage.
+type State struct {
+	accounts map[common.Address]*types.StateAccount
+	storage  map[common.Address]map[common.Hash]common.Hash
+}
+
+// NewState returns an empty state.
+func NewState() *State {
+	return &State{
+		accounts: make(map[common.Address]*types.StateAccount),
+		storage:  make(map[common.Address]map[common.Hash]common.Hash),
+	}
+}
+
+// SetAccount sets the nonce, balance and code hash of the account at the address.
+func (s *State) SetAccount(address common.Address, nonce uint64, balance *uint256.Int, codeHash common.Hash) {
+	s.accounts[address] = &types.StateAccount{
+		Nonce:    nonce,
+		Balance:  balance,
+		Root:     types.EmptyRootHash,
+		CodeHash: codeHash.Bytes(),
+	}
+}
+
+// SetStorage sets the value of the storage slot of the account at the address. Setting a slot to zero
+// removes it from the storage trie.
+func (s *State) SetStorage(address common.Address, slot common.Hash, value common.Hash) {
+	if s.storage[address] == nil {
+		s.storage[address] = make(map[common.Hash]common.Hash)
+	}
+	s.storage[address][slot] = value
+}
+
+// Root returns the state root.
+func (s *State) Root() (common.Hash, error) {
+	stateTrie, _, err := s.tries()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return stateTrie.Hash(), nil
+}
+
+// GetProof returns the proof of the account at the address and of the given storage slots in the shape
+// of an eth_getProof response.
+func (s *State) GetProof(address common.Address, slots ...common.Hash) (*AccountResult, error) {
+	account, ok := s.accounts[address]
+	if !ok {
+		return nil, fmt.Errorf("account %s does not exist", address)
+	}
+
+	stateTrie, storageTries, err := s.tries()
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof, err := prove(stateTrie, crypto.Keccak256(address.Bytes()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prove account %s: %w", address, err)
+	}
+
+	result := &AccountResult{
+		AccountProof: accountProof,
+		Address:      address,
+		Balance:      (*hexutil.Big)(account.Balance.ToBig()),
+		CodeHash:     common.BytesToHash(account.CodeHash),
+		Nonce:        hexutil.Uint64(account.Nonce),
+		StorageHash:  storageTries[address].Hash(),
+		StorageProof: make([]StorageResult, len(slots)),
+	}
+	for i, slot := range slots {
+		storageProof, err := prove(storageTries[address], crypto.Keccak256(slot.Bytes()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to prove storage slot %s of account %s: %w", slot, address, err)
+		}
+
+		value := s.storage[address][slot]
+		result.StorageProof[i] = StorageResult{
+			Key:   slot,
+			Value: (*hexutil.Big)(value.Big()),
+			Proof: storageProof,
+		}
+	}
+
+	return result, nil
+}
+
+// tries builds the state trie and the storage tries of all accounts. Accounts are inserted in address
+// order so that the tries do not depend on map iteration order.
+func (s *State) tries() (*gethtrie.Trie, map[common.Address]*gethtrie.Trie, error) {
+	addresses := make([]common.Address, 0, len(s.accounts))
+	for address := range s.accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].Cmp(addresses[j]) < 0
+	})
+
+	stateTrie := newTrie()
+	storageTries := make(map[common.Address]*gethtrie.Trie, len(addresses))
+	for _, address := range addresses {
+		storageTrie := newTrie()
+		for slot, value := range s.storage[address] {
+			if value == (common.Hash{}) {
+				continue
+			}
+			// storage values are stored as the rlp encoding of the 32 byte word without leading zeroes
+			encodedValue, err := rlp.EncodeToBytes(common.TrimLeftZeroes(value.Bytes()))
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to encode storage slot %s of account %s: %w", slot, address, err)
+			}
+			storageTrie.MustUpdate(crypto.Keccak256(slot.Bytes()), encodedValue)
+		}
+		storageTries[address] = storageTrie
+
+		account := *s.accounts[address]
+		account.Root = storageTrie.Hash()
+		encodedAccount, err := rlp.EncodeToBytes(&account)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode account %s: %w", address, err)
+		}
+		stateTrie.MustUpdate(crypto.Keccak256(address.Bytes()), encodedAccount)
+	}
+
+	return stateTrie, storageTries, nil
+}
+
+// prove returns the trie nodes on the path of the key.
+func prove(trie *gethtrie.Trie, key []byte) ([]hexutil.Bytes, error) {
+	var proof proofNodes
+	if err := trie.Prove(key, &proof); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// newTrie returns an empty in-memory trie. Its nodes are never committed, so it does not need a node
+// database to read them back.
+func newTrie() *gethtrie.Trie {
+	return gethtrie.NewEmpty(emptyNodeDatabase{})
+}
+
+// emptyNodeDatabase is a database.NodeDatabase without any nodes for tries that are only kept in memory.
+type emptyNodeDatabase struct{}
+
+var _ database.NodeDatabase = emptyNodeDatabase{}
+
+// NodeReader implements database.NodeDatabase. There is no state to read nodes of.
+func (emptyNodeDatabase) NodeReader(stateRoot common.Hash) (database.NodeReader, error) {
+	return nil, fmt.Errorf("no trie nodes stored for state root %s", stateRoot)
+}
+
+// proofNodes is an ethdb.KeyValueWriter that collects the trie nodes written by Trie.Prove in order.
+type proofNodes []hexutil.Bytes
+
+var _ ethdb.KeyValueWriter = (*proofNodes)(nil)
+
+// Put implements ethdb.KeyValueWriter.
+func (p *proofNodes) Put(_ []byte, value []byte) error {
+	*p = append(*p, common.CopyBytes(value))
+	return nil
+}
+
+// Delete implements ethdb.KeyValueWriter.
+func (p *proofNodes) Delete(_ []byte) error {
+	return errors.New("proof nodes cannot be deleted")
+}
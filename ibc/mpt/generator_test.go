@@ -0,0 +1,44 @@
+package mpt
+
+import (
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRouterFixture(t *testing.T) {
+	routerAddress := ethcommon.HexToAddress("0xe53275a1fca119e1c5eeb32e7a72e54835a63936")
+	storage := make(map[ethcommon.Hash]ethcommon.Hash)
+	var slots []ethcommon.Hash
+	for i := 0; i < 16; i++ {
+		slot := crypto.Keccak256Hash([]byte{byte(i)})
+		storage[slot] = crypto.Keccak256Hash(slot.Bytes())
+		slots = append(slots, slot)
+	}
+	absentSlot := crypto.Keccak256Hash([]byte("absent"))
+
+	fixture, err := GenerateRouterFixture(routerAddress, storage, 8, append(slots, absentSlot)...)
+	require.NoError(t, err)
+
+	account, err := VerifyAccountProof(fixture.StateRoot, routerAddress, fixture.Proof.AccountProof)
+	require.NoError(t, err)
+	require.Equal(t, fixture.Proof.StorageHash, account.Root)
+	require.Equal(t, RouterCodeHash.Bytes(), account.CodeHash)
+
+	require.Len(t, fixture.Proof.StorageProof, len(slots)+1)
+	for i, storageProof := range fixture.Proof.StorageProof {
+		value, err := VerifyStorageProof(account.Root, storageProof.Key, storageProof.Proof)
+		require.NoError(t, err)
+		require.Equal(t, storageProof.Value.ToInt(), value.ToBig())
+
+		if i < len(slots) {
+			require.Equal(t, slots[i], storageProof.Key)
+			require.Equal(t, storage[slots[i]].Big(), value.ToBig())
+		} else {
+			require.Equal(t, absentSlot, storageProof.Key)
+			require.NoError(t, VerifyExclusionProof(account.Root, crypto.Keccak256(absentSlot.Bytes()), storageProof.Proof))
+		}
+	}
+}
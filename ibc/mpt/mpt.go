@@ -1,11 +1,8 @@
 package mpt
 
 import (
-	"fmt"
-
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/trie"
@@ -13,27 +10,46 @@ import (
 
 // VerifyMerklePatriciaTrieProof verifies MPT proofs with IBC public inputs
 func VerifyMerklePatriciaTrieProof(rootHash ethcommon.Hash, key []byte, proof []hexutil.Bytes) (value []byte, err error) {
-	proofDB, err := ReconstructProofDB(proof)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode proof: %w", err)
-	}
-	return trie.VerifyProof(rootHash, key, proofDB)
+	return trie.VerifyProof(rootHash, key, ReconstructProofDB(proof))
 }
 
-// ReconstructProofDB iterates over all nodes in proof and returns a database of key value pairs where:
+// ReconstructProofDB iterates over all nodes in proof and returns a read-only database of key value pairs where:
 // - key is the node hash if the node is larger than 32 bytes, otherwise the node itself
-// - value is the node encoded as hex
-func ReconstructProofDB(proof []hexutil.Bytes) (ethdb.Database, error) {
-	proofDB := rawdb.NewMemoryDatabase()
-	for i, encodedNode := range proof {
-		nodeKey := encodedNode
-		if len(encodedNode) >= 32 { // small MPT nodes are not hashed
-			nodeKey = crypto.Keccak256(encodedNode)
-		}
-		if err := proofDB.Put(nodeKey, encodedNode); err != nil {
-			return nil, fmt.Errorf("failed to load proof node %d into mem db: %w", i, err)
-		}
+// - value is the encoded node
+func ReconstructProofDB(proof []hexutil.Bytes) ethdb.KeyValueReader {
+	proofDB := make(proofDB, len(proof))
+	for _, encodedNode := range proof {
+		proofDB[string(proofNodeKey(encodedNode))] = encodedNode
+	}
+
+	return proofDB
+}
+
+// proofNodeKey returns the key of the proof node in the proof database.
+func proofNodeKey(encodedNode []byte) []byte {
+	if len(encodedNode) < 32 { // small MPT nodes are not hashed
+		return encodedNode
 	}
+	return crypto.Keccak256(encodedNode)
+}
+
+// proofDB is a map backed ethdb.KeyValueReader of proof nodes keyed by proofNodeKey. It only holds the
+// nodes of a single proof, so verification does not allocate a full go-ethereum memory database.
+type proofDB map[string][]byte
+
+var _ ethdb.KeyValueReader = proofDB(nil)
 
-	return proofDB, nil
+// Has implements ethdb.KeyValueReader.
+func (db proofDB) Has(key []byte) (bool, error) {
+	_, ok := db[string(key)]
+	return ok, nil
+}
+
+// Get implements ethdb.KeyValueReader.
+func (db proofDB) Get(key []byte) ([]byte, error) {
+	node, ok := db[string(key)]
+	if !ok {
+		return nil, ErrMissingNode
+	}
+	return node, nil
 }
@@ -0,0 +1,93 @@
+package mpt
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/mpt/mpttest"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	gethtrie "github.com/ethereum/go-ethereum/trie"
+)
+
+// rawdbProofDB is the proof database that proofs were previously verified against: a go-ethereum memory
+// database holding the proof nodes keyed by proofNodeKey.
+func rawdbProofDB(proof []hexutil.Bytes) ethdb.KeyValueReader {
+	proofDB := rawdb.NewMemoryDatabase()
+	for _, encodedNode := range proof {
+		if err := proofDB.Put(proofNodeKey(encodedNode), encodedNode); err != nil {
+			panic(err)
+		}
+	}
+	return proofDB
+}
+
+// proofReaders are the proof databases compared by the benchmarks.
+var proofReaders = []struct {
+	name   string
+	reader func([]hexutil.Bytes) ethdb.KeyValueReader
+}{
+	{"map", ReconstructProofDB},
+	{"rawdb", rawdbProofDB},
+}
+
+func BenchmarkVerifyInclusionProof(b *testing.B) {
+	trie, vals := mpttest.RandomTrie(1000)
+	root := trie.Hash()
+
+	var key []byte
+	for _, kv := range vals {
+		key = kv.K
+		break
+	}
+	proof := benchmarkProof(b, trie, key)
+
+	benchmarkVerifyProof(b, root, key, proof, true)
+}
+
+func BenchmarkVerifyExclusionProof(b *testing.B) {
+	trie, _ := mpttest.RandomTrie(1000)
+	root := trie.Hash()
+
+	key := make([]byte, 32)
+	key[0] = 0xff
+	proof := benchmarkProof(b, trie, key)
+
+	benchmarkVerifyProof(b, root, key, proof, false)
+}
+
+// benchmarkVerifyProof benchmarks building the proof database and verifying the proof of the key
+// against it for every proof reader.
+func benchmarkVerifyProof(b *testing.B, root ethcommon.Hash, key []byte, proof []hexutil.Bytes, exists bool) {
+	for _, pr := range proofReaders {
+		b.Run(pr.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				value, err := gethtrie.VerifyProof(root, key, pr.reader(proof))
+				if err != nil {
+					b.Fatal(err)
+				}
+				if (value != nil) != exists {
+					b.Fatalf("unexpected value %x", value)
+				}
+			}
+		})
+	}
+}
+
+// benchmarkProof returns the proof of the presence or absence of the key in the trie.
+func benchmarkProof(b *testing.B, trie *gethtrie.Trie, key []byte) []hexutil.Bytes {
+	b.Helper()
+
+	var proofList mpttest.ProofList
+	if err := trie.Prove(key, &proofList); err != nil {
+		b.Fatal(err)
+	}
+
+	proof := make([]hexutil.Bytes, len(proofList))
+	for i, node := range proofList {
+		proof[i] = hexutil.MustDecode(node)
+	}
+	return proof
+}
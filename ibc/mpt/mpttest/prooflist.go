@@ -1,8 +1,8 @@
-package mpt
+package mpttest
 
 import "github.com/ethereum/go-ethereum/common/hexutil"
 
-// proofList implements ethdb.KeyValueWriter and collects the proofs as
+// ProofList implements ethdb.KeyValueWriter and collects the proofs as
 // hex-strings for delivery to rpc-caller.
 type ProofList []string
 
@@ -0,0 +1,77 @@
+// Package mpttest provides helpers to build tries and proofs for testing the verification of Merkle
+// Patricia Trie proofs. It must not be imported by production code.
+package mpttest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+
+	crand "crypto/rand"
+	mrand "math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gethtrie "github.com/ethereum/go-ethereum/trie"
+)
+
+// KV is a key value pair of a trie.
+type KV struct {
+	K, V []byte
+}
+
+var prng = initRnd()
+
+// RandomTrie returns a trie with a fixed set of small keys and n random keys, and the key value pairs
+// of the trie keyed by their key.
+func RandomTrie(n int) (trie *gethtrie.Trie, vals map[string]*KV) {
+	trie = NewTrie()
+	vals = make(map[string]*KV)
+	for i := byte(0); i < 100; i++ {
+		value := &KV{common.LeftPadBytes([]byte{i}, 32), []byte{i}}
+		value2 := &KV{common.LeftPadBytes([]byte{i + 10}, 32), []byte{i}}
+		trie.MustUpdate(value.K, value.V)
+		trie.MustUpdate(value2.K, value2.V)
+		vals[string(value.K)] = value
+		vals[string(value2.K)] = value2
+	}
+	for i := 0; i < n; i++ {
+		value := &KV{randBytes(32), randBytes(20)}
+		trie.MustUpdate(value.K, value.V)
+		vals[string(value.K)] = value
+	}
+	return trie, vals
+}
+
+// NewTrie returns an empty trie backed by an in-memory node database.
+func NewTrie() *gethtrie.Trie {
+	return gethtrie.NewEmpty(newTestDatabase(rawdb.NewMemoryDatabase(), rawdb.HashScheme))
+}
+
+func initRnd() *mrand.Rand {
+	var seed [8]byte
+	_, err := crand.Read(seed[:])
+	if err != nil {
+		panic(fmt.Sprintf("failed to read random seed: %v", err))
+	}
+
+	rnd := mrand.New(mrand.NewSource(int64(binary.LittleEndian.Uint64(seed[:]))))
+	return rnd
+}
+
+func randBytes(n int) []byte {
+	r := make([]byte, n)
+	prng.Read(r)
+	return r
+}
+
+// ProofListToBytes converts the proof list to []byte by encoding it with gob.
+func ProofListToBytes(proof ProofList) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := gob.NewEncoder(&buf)
+	if err := encoder.Encode(proof); err != nil {
+		return nil, fmt.Errorf("failed to encode proofList to bytes: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,84 @@
+package mpt
+
+import (
+	"errors"
+	"fmt"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ErrDuplicateKey is returned if a multiproof contains a key more than once.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// MultiProof is a proof of several keys of one trie. The nodes on the paths of all keys are only
+// included once, so keys that share a prefix share the nodes of that prefix.
+type MultiProof struct {
+	// Keys are the paths in the trie, i.e. the keccak256 hashes of the account addresses or storage
+	// slots for the secure tries of the EVM state.
+	Keys []hexutil.Bytes `json:"keys"`
+	// Nodes are the deduplicated trie nodes on the paths of all keys.
+	Nodes []hexutil.Bytes `json:"nodes"`
+}
+
+// VerifyMultiProof verifies all keys of the multiproof against the root in a single pass and returns the
+// values at the keys in the order of the keys. Absent keys have a nil value. Every node of the multiproof
+// must be on the path of at least one key.
+func VerifyMultiProof(root ethcommon.Hash, proof *MultiProof) ([][]byte, error) {
+	keys := make([][]byte, len(proof.Keys))
+	seen := make(map[string]bool, len(proof.Keys))
+	for i, key := range proof.Keys {
+		if seen[string(key)] {
+			return nil, fmt.Errorf("%w: %x", ErrDuplicateKey, []byte(key))
+		}
+		seen[string(key)] = true
+		keys[i] = key
+	}
+
+	return verifyKeys(root, keys, proof.Nodes)
+}
+
+// MultiProofBuilder merges the proofs of keys of one trie, e.g. the storage proofs of several
+// eth_getProof responses for the same account and block, into a MultiProof.
+type MultiProofBuilder struct {
+	proof MultiProof
+	keys  map[string]bool
+	nodes map[string]bool
+}
+
+// NewMultiProofBuilder returns an empty MultiProofBuilder.
+func NewMultiProofBuilder() *MultiProofBuilder {
+	return &MultiProofBuilder{
+		keys:  make(map[string]bool),
+		nodes: make(map[string]bool),
+	}
+}
+
+// Add adds the proof of the key to the multiproof. Nodes that are already part of the multiproof are
+// skipped. Adding the same key twice is an error.
+func (b *MultiProofBuilder) Add(key []byte, proof []hexutil.Bytes) error {
+	if b.keys[string(key)] {
+		return fmt.Errorf("%w: %x", ErrDuplicateKey, key)
+	}
+	b.keys[string(key)] = true
+	b.proof.Keys = append(b.proof.Keys, ethcommon.CopyBytes(key))
+
+	for _, node := range proof {
+		nodeKey := string(proofNodeKey(node))
+		if b.nodes[nodeKey] {
+			continue
+		}
+		b.nodes[nodeKey] = true
+		b.proof.Nodes = append(b.proof.Nodes, ethcommon.CopyBytes(node))
+	}
+
+	return nil
+}
+
+// Build returns the multiproof of all keys added so far.
+func (b *MultiProofBuilder) Build() *MultiProof {
+	return &MultiProof{
+		Keys:  append([]hexutil.Bytes(nil), b.proof.Keys...),
+		Nodes: append([]hexutil.Bytes(nil), b.proof.Nodes...),
+	}
+}
@@ -0,0 +1,196 @@
+package mpt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+)
+
+var (
+	// ErrHashMismatch is returned if no proof node hashes to the root the proof is verified against.
+	ErrHashMismatch = errors.New("proof does not match root hash")
+	// ErrMissingNode is returned if a node on the path of the key is not part of the proof.
+	ErrMissingNode = errors.New("missing proof node")
+	// ErrExtraNodes is returned if the proof contains nodes that are not on the path of the key.
+	ErrExtraNodes = errors.New("proof contains nodes that are not on the path of the key")
+	// ErrInvalidNode is returned if a proof node is not a valid trie node.
+	ErrInvalidNode = errors.New("invalid proof node")
+	// ErrAccountNotFound is returned if the account proof proves the absence of the account.
+	ErrAccountNotFound = errors.New("account not found")
+	// ErrInvalidValue is returned if the value proven at a key is not a valid account or storage value.
+	ErrInvalidValue = errors.New("invalid proof value")
+	// ErrKeyExists is returned if an exclusion proof proves the presence of the key.
+	ErrKeyExists = errors.New("key exists")
+)
+
+// emptyNode is the rlp encoding of the empty trie node, it hashes to the root of the empty trie.
+var emptyNode = []byte{0x80}
+
+// VerifyAccountProof verifies the proof of the account at the address against the state root and
+// returns the account state. The proof must consist of exactly the nodes on the path of the account,
+// as returned in the accountProof of an eth_getProof response.
+func VerifyAccountProof(root ethcommon.Hash, address ethcommon.Address, proof []hexutil.Bytes) (*types.StateAccount, error) {
+	value, err := verifyProof(root, crypto.Keccak256(address.Bytes()), proof)
+	if err != nil {
+		return nil, fmt.Errorf("account %s: %w", address, err)
+	}
+	if value == nil {
+		return nil, fmt.Errorf("%w: %s", ErrAccountNotFound, address)
+	}
+
+	var account types.StateAccount
+	if err := rlp.DecodeBytes(value, &account); err != nil {
+		return nil, fmt.Errorf("%w: account %s: %v", ErrInvalidValue, address, err)
+	}
+
+	return &account, nil
+}
+
+// VerifyStorageProof verifies the proof of the storage slot against the storage root of an account and
+// returns the value of the slot. Slots that are absent from the storage trie hold zero. The proof must
+// consist of exactly the nodes on the path of the slot, as returned in the storageProof of an
+// eth_getProof response.
+func VerifyStorageProof(storageRoot ethcommon.Hash, slot ethcommon.Hash, proof []hexutil.Bytes) (*uint256.Int, error) {
+	value, err := verifyProof(storageRoot, crypto.Keccak256(slot.Bytes()), proof)
+	if err != nil {
+		return nil, fmt.Errorf("storage slot %s: %w", slot, err)
+	}
+	if value == nil {
+		return new(uint256.Int), nil
+	}
+
+	word, err := DecodeStorageValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("storage slot %s: %w", slot, err)
+	}
+
+	return word, nil
+}
+
+// DecodeStorageValue decodes the value of a storage slot as stored in the storage trie: the rlp encoding
+// of the 32 byte word without leading zeroes.
+func DecodeStorageValue(value []byte) (*uint256.Int, error) {
+	var word []byte
+	if err := rlp.DecodeBytes(value, &word); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidValue, err)
+	}
+	if len(word) > 32 || len(word) == 0 || word[0] == 0 {
+		return nil, fmt.Errorf("%w: non-canonical storage value %x", ErrInvalidValue, word)
+	}
+
+	return new(uint256.Int).SetBytes(word), nil
+}
+
+// VerifyExclusionProof verifies that the key is absent from the trie with the given root. The key is
+// the path in the trie, i.e. the keccak256 hash of the account address or storage slot for the secure
+// tries of the EVM state. The walk along the path of the key must end in one of the following:
+//   - an empty child of a branch node at the next nibble of the key
+//   - an extension node whose shared nibbles diverge from the key
+//   - a leaf node whose remaining nibbles differ from the key
+//
+// An empty trie contains no keys and is proven by an empty proof or a proof of the empty node. Proofs
+// that end in the value of the key are rejected with ErrKeyExists.
+func VerifyExclusionProof(root ethcommon.Hash, key []byte, proof []hexutil.Bytes) error {
+	value, err := verifyProof(root, key, proof)
+	if err != nil {
+		return err
+	}
+	if value != nil {
+		return fmt.Errorf("%w: key %x has value %x", ErrKeyExists, key, value)
+	}
+
+	return nil
+}
+
+// verifyProof verifies the proof of the hashed key against the root and returns the value at the key, or
+// nil if the proof proves the absence of the key. Every proof node must be on the path of the key.
+func verifyProof(root ethcommon.Hash, key []byte, proof []hexutil.Bytes) ([]byte, error) {
+	values, err := verifyKeys(root, [][]byte{key}, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return values[0], nil
+}
+
+// verifyKeys verifies the proof of the hashed keys against the root in a single pass over a shared proof
+// database and returns the values at the keys, which are nil for absent keys. Every proof node must be
+// on the path of at least one key and may only be part of the proof once.
+func verifyKeys(root ethcommon.Hash, keys [][]byte, proof []hexutil.Bytes) ([][]byte, error) {
+	values := make([][]byte, len(keys))
+
+	// the empty trie has no root node, so its proof has no nodes or only the encoded empty node
+	if root == types.EmptyRootHash {
+		if len(proof) > 1 || (len(proof) == 1 && !bytes.Equal(proof[0], emptyNode)) {
+			return nil, fmt.Errorf("%w: %d nodes for empty trie", ErrExtraNodes, len(proof))
+		}
+		return values, nil
+	}
+
+	reader := newTrackingReader(ReconstructProofDB(proof))
+	for i, key := range keys {
+		value, err := trie.VerifyProof(root, key, reader)
+		if err != nil {
+			switch {
+			case reader.missing == nil:
+				return nil, fmt.Errorf("%w: key %x: %v", ErrInvalidNode, key, err)
+			case bytes.Equal(reader.missing, root.Bytes()):
+				return nil, fmt.Errorf("%w: %s", ErrHashMismatch, root)
+			default:
+				return nil, fmt.Errorf("%w: key %x: %x", ErrMissingNode, key, reader.missing)
+			}
+		}
+		values[i] = value
+	}
+
+	seen := make(map[string]bool, len(proof))
+	for i, node := range proof {
+		nodeKey := string(proofNodeKey(node))
+		if !reader.read[nodeKey] || seen[nodeKey] {
+			return nil, fmt.Errorf("%w: node %d", ErrExtraNodes, i)
+		}
+		seen[nodeKey] = true
+	}
+
+	return values, nil
+}
+
+// trackingReader records the keys read from the underlying reader and the first key that is missing.
+type trackingReader struct {
+	ethdb.KeyValueReader
+	read    map[string]bool
+	missing []byte
+}
+
+var _ ethdb.KeyValueReader = (*trackingReader)(nil)
+
+// newTrackingReader returns a reader that tracks the reads from the given reader.
+func newTrackingReader(reader ethdb.KeyValueReader) *trackingReader {
+	return &trackingReader{
+		KeyValueReader: reader,
+		read:           make(map[string]bool),
+	}
+}
+
+// Get implements ethdb.KeyValueReader.
+func (r *trackingReader) Get(key []byte) ([]byte, error) {
+	value, err := r.KeyValueReader.Get(key)
+	if err != nil {
+		if r.missing == nil {
+			r.missing = ethcommon.CopyBytes(key)
+		}
+		return nil, err
+	}
+
+	r.read[string(key)] = true
+	return value, nil
+}
@@ -0,0 +1,157 @@
+package mpt
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/mpt/mpttest"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethtrie "github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+)
+
+// exclusion proof forms classified by the last node of the proof
+const (
+	emptyBranchChild = iota
+	mismatchedExtension
+	mismatchedLeaf
+)
+
+func TestVerifyExclusionProofRandomTrie(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	forms := make(map[int]int)
+
+	for i := 0; i < 10; i++ {
+		trie, vals := mpttest.RandomTrie(500)
+		root := trie.Hash()
+
+		for _, kv := range vals {
+			proof := proveKey(t, trie, kv.K)
+			err := VerifyExclusionProof(root, kv.K, proof)
+			require.ErrorIs(t, err, ErrKeyExists, "key %x", kv.K)
+
+			value, err := verifyProof(root, kv.K, proof)
+			require.NoError(t, err)
+			require.Equal(t, kv.V, value)
+		}
+
+		for _, key := range absentKeys(rnd, vals) {
+			proof := proveKey(t, trie, key)
+			require.NoError(t, VerifyExclusionProof(root, key, proof), "key %x", key)
+			forms[exclusionForm(t, proof[len(proof)-1])]++
+		}
+	}
+
+	// every form of exclusion proof is exercised
+	require.Positive(t, forms[emptyBranchChild])
+	require.Positive(t, forms[mismatchedExtension])
+	require.Positive(t, forms[mismatchedLeaf])
+}
+
+func TestVerifyExclusionProofEmptyTrie(t *testing.T) {
+	key := make([]byte, 32)
+
+	require.NoError(t, VerifyExclusionProof(types.EmptyRootHash, key, nil))
+	require.NoError(t, VerifyExclusionProof(types.EmptyRootHash, key, []hexutil.Bytes{emptyNode}))
+	require.ErrorIs(t, VerifyExclusionProof(types.EmptyRootHash, key, []hexutil.Bytes{emptyNode, emptyNode}), ErrExtraNodes)
+}
+
+func TestVerifyExclusionProofInvalidProof(t *testing.T) {
+	trie, vals := mpttest.RandomTrie(100)
+	root := trie.Hash()
+	key := absentKeys(rand.New(rand.NewSource(1)), vals)[0]
+	proof := proveKey(t, trie, key)
+	require.NoError(t, VerifyExclusionProof(root, key, proof))
+
+	// a proof against another root
+	require.ErrorIs(t, VerifyExclusionProof(ethcommon.Hash{1}, key, proof), ErrHashMismatch)
+
+	// a proof without the last node on the path of the key
+	require.ErrorIs(t, VerifyExclusionProof(root, key, proof[:len(proof)-1]), ErrMissingNode)
+
+	// a proof with a node of another path
+	var presentKey []byte
+	for _, kv := range vals {
+		presentKey = kv.K
+		break
+	}
+	presentProof := proveKey(t, trie, presentKey)
+	extraProof := append(append([]hexutil.Bytes(nil), proof...), presentProof[len(presentProof)-1])
+	require.ErrorIs(t, VerifyExclusionProof(root, key, extraProof), ErrExtraNodes)
+}
+
+// proveKey returns the proof of the presence or absence of the key in the trie.
+func proveKey(t *testing.T, trie *gethtrie.Trie, key []byte) []hexutil.Bytes {
+	t.Helper()
+
+	var proofList mpttest.ProofList
+	require.NoError(t, trie.Prove(key, &proofList))
+
+	proof := make([]hexutil.Bytes, len(proofList))
+	for i, node := range proofList {
+		proof[i] = hexutil.MustDecode(node)
+	}
+	return proof
+}
+
+// absentKeys returns keys that are not part of the trie: random keys, which mostly end in an empty
+// branch child, present keys with a changed last byte, which mostly end in a leaf of the present key,
+// and small keys with a changed inner byte, which diverge from the extension shared by the small keys
+// of RandomTrie.
+func absentKeys(rnd *rand.Rand, vals map[string]*mpttest.KV) [][]byte {
+	var keys [][]byte
+	for i := 0; i < 50; i++ {
+		key := make([]byte, 32)
+		rnd.Read(key)
+		keys = append(keys, key)
+	}
+
+	for _, kv := range vals {
+		key := ethcommon.CopyBytes(kv.K)
+		key[len(key)-1] ^= 0xff
+		keys = append(keys, key)
+
+		key = ethcommon.CopyBytes(kv.K)
+		key[16] ^= byte(1 + rnd.Intn(255))
+		keys = append(keys, key)
+
+		if len(keys) >= 200 {
+			break
+		}
+	}
+
+	absent := keys[:0]
+	for _, key := range keys {
+		if _, ok := vals[string(key)]; !ok {
+			absent = append(absent, key)
+		}
+	}
+	return absent
+}
+
+// exclusionForm classifies the last node of an exclusion proof, where the path of the key leaves the
+// trie, as a branch node, an extension node or a leaf node.
+func exclusionForm(t *testing.T, node []byte) int {
+	t.Helper()
+
+	var elems []rlp.RawValue
+	require.NoError(t, rlp.DecodeBytes(node, &elems))
+	switch len(elems) {
+	case 17:
+		return emptyBranchChild
+	case 2:
+		var key []byte
+		require.NoError(t, rlp.DecodeBytes(elems[0], &key))
+		// the first nibble of the compact encoded key flags leaf nodes with 2 or 3
+		if key[0]>>4 >= 2 {
+			return mismatchedLeaf
+		}
+		return mismatchedExtension
+	default:
+		t.Fatalf("invalid trie node %x", node)
+		return 0
+	}
+}
@@ -1,7 +1,7 @@
 //! A SP1 program that takes as input N verification keys and N public values from N blevm proofs.
 //! It then verifies those proofs. It verifies that each proof is for an EVM block immediately
 //! following the previous block. It commits to the EVM header hashes from the first and last
-//! blocks. Note that the proofs must be in order of increasing block height.
+//! blocks and to the parent hash of the first block. Note that the proofs must be in order of increasing block height.
 #![no_main]
 sp1_zkvm::entrypoint!(main);
 
@@ -50,6 +50,7 @@ pub fn main() {
     let agg_output = BlevmAggOutput {
         newest_header_hash: outputs[vkeys.len() - 1].header_hash,
         oldest_header_hash: outputs[0].header_hash,
+        trusted_header_hash: outputs[0].prev_header_hash,
         celestia_header_hashes,
         newest_state_root: outputs[vkeys.len() - 1].state_root,
         newest_height: outputs[vkeys.len() - 1].height,
@@ -20,6 +20,10 @@ pub struct BlevmAggOutput {
     // oldest_header_hash is the earliest block's hash on the EVM roll-up.
     // TODO: this may be removable.
     pub oldest_header_hash: [u8; 32],
+    // trusted_header_hash is the hash of the parent of the earliest block on
+    // the EVM roll-up. Light clients require it to match the header hash of
+    // the trusted state that the proven range of blocks builds on.
+    pub trusted_header_hash: [u8; 32],
     // celestia_header_hashes is the range of Celestia blocks that include all
     // of the blob data the EVM roll-up has posted from oldest_header_hash to
     // newest_header_hash.
@@ -292,7 +292,7 @@ func NewSimApp(
 		panic(err)
 	}
 
-	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, groth16.TransientStoreKey)
 
 	app := &SimApp{
 		BaseApp:           bApp,
@@ -439,7 +439,7 @@ func NewSimApp(
 	tmLightClientModule := ibctendermint.NewLightClientModule(appCodec, storeProvider)
 	clientKeeper.AddRoute(ibctendermint.ModuleName, &tmLightClientModule)
 
-	groth16LightClientModule := groth16.NewLightClientModule(appCodec, storeProvider)
+	groth16LightClientModule := groth16.NewLightClientModule(appCodec, storeProvider, tkeys[groth16.TransientStoreKey])
 	clientKeeper.AddRoute(groth16.ModuleName, &groth16LightClientModule)
 
 	smLightClientModule := ibcsolomachine.NewLightClientModule(appCodec, storeProvider)
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -13,6 +12,7 @@ import (
 	"github.com/celestiaorg/celestia-zkevm-ibc-demo/testing/demo/pkg/utils"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"google.golang.org/grpc"
@@ -82,18 +82,36 @@ func createClientAndConsensusState() (*cdctypes.Any, *cdctypes.Any, error) {
 	// TODO: Query the codeCommitment from the EVM rollup.
 	codeCommitment := []byte{}
 
-	groth16Vk, err := getGroth16Vk()
+	// Devnets running the SP1 mock prover produce empty proofs that are accepted by the mock
+	// proof system without a verifying key.
+	proofSystem := groth16.ProofSystem_PROOF_SYSTEM_SP1_GROTH16
+	var groth16Vk []byte
+	if os.Getenv("SP1_PROVER") == "mock" {
+		proofSystem = groth16.ProofSystem_PROOF_SYSTEM_MOCK
+	} else {
+		groth16Vk, err = getGroth16Vk()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get groth16 vk: %w", err)
+		}
+	}
+
+	addresses, err := utils.ExtractDeployedContractAddresses()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get groth16 vk: %w", err)
+		return nil, nil, fmt.Errorf("failed to get deployed contract addresses: %w", err)
 	}
 
 	clientState := groth16.NewClientState(
-		latestBlock.Number().Uint64(),
+		ethChainId.String(),
+		clienttypes.NewHeight(0, latestBlock.Number().Uint64()),
+		proofSystem,
 		evmProverInfo.StateTransitionVerifierKey,
 		stateMembershipVerifierKey,
 		groth16Vk,
 		codeCommitment,
 		genesisBlock.Root().Bytes(),
+		groth16.DefaultTrustingPeriod,
+		groth16.DefaultMaxClockDrift,
+		ethcommon.HexToAddress(addresses.ICS26Router),
 	)
 	clientStateAny, err := cdctypes.NewAnyWithValue(clientState)
 	if err != nil {
@@ -101,7 +119,7 @@ func createClientAndConsensusState() (*cdctypes.Any, *cdctypes.Any, error) {
 	}
 
 	latestBlockTime := time.Unix(int64(latestBlock.Time()), 0)
-	consensusState := groth16.NewConsensusState(latestBlockTime, latestBlock.Root().Bytes())
+	consensusState := groth16.NewConsensusState(latestBlockTime, latestBlock.Root().Bytes(), latestBlock.Hash().Bytes())
 	consensusStateAny, err := cdctypes.NewAnyWithValue(consensusState)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create consensus state any: %v", err)
@@ -178,13 +196,16 @@ func getEvmProverInfo() (*proverclient.InfoResponse, error) {
 	return info, nil
 }
 
+// getGroth16Vk reads the SP1 Groth16 verifying key from the file at GROTH16_VK_PATH.
 func getGroth16Vk() ([]byte, error) {
-	dir, err := os.Getwd()
-	buf := bytes.NewBuffer(nil)
-	vkFile, err := os.Open(dir + "/ibc/lightclients/groth16/groth16_vk.bin")
+	vkPath := os.Getenv("GROTH16_VK_PATH")
+	if vkPath == "" {
+		return nil, fmt.Errorf("GROTH16_VK_PATH is not set")
+	}
+
+	vk, err := os.ReadFile(vkPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open vk file %w", err)
+		return nil, fmt.Errorf("failed to read vk file %w", err)
 	}
-	buf.ReadFrom(vkFile)
-	return buf.Bytes(), nil
+	return vk, nil
 }
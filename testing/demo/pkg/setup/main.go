@@ -5,7 +5,12 @@ import (
 )
 
 func main() {
-	err := CreateGroth16LightClient()
+	err := DeployEurekaContracts()
+	if err != nil {
+		log.Fatalf("Failed to deploy IBC Eureka contracts: %v", err)
+	}
+
+	err = CreateGroth16LightClient()
 	if err != nil {
 		log.Fatalf("Failed to create Groth16 light client: %v", err)
 	}
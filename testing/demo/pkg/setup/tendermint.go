@@ -22,15 +22,6 @@ func CreateTendermintLightClient() error {
 		return fmt.Errorf("simapp node is not healthy, please ensure it is running correctly: %w", err)
 	}
 
-	err = utils.CheckEthereumNodeHealth(ethereumRPC)
-	if err != nil {
-		return fmt.Errorf("ethereum node is not healthy, please ensure it is running correctly: %w", err)
-	}
-
-	if err := deployEurekaContracts(); err != nil {
-		return err
-	}
-
 	addresses, err := utils.ExtractDeployedContractAddresses()
 	if err != nil {
 		return err
@@ -43,6 +34,18 @@ func CreateTendermintLightClient() error {
 	return nil
 }
 
+// DeployEurekaContracts deploys the IBC Eureka contracts on the EVM roll-up. The contracts
+// are deployed before the light clients are created because the Groth16 light client pins the
+// address of the ICS26Router contract.
+func DeployEurekaContracts() error {
+	err := utils.CheckEthereumNodeHealth(ethereumRPC)
+	if err != nil {
+		return fmt.Errorf("ethereum node is not healthy, please ensure it is running correctly: %w", err)
+	}
+
+	return deployEurekaContracts()
+}
+
 // deployEurekaContracts deploys all of the IBC Eureka contracts (including the
 // SP1 ICS07 Tendermint light client contract) on the EVM roll-up.
 func deployEurekaContracts() error {
@@ -9,11 +9,11 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/lightclients/groth16"
 	"github.com/celestiaorg/celestia-zkevm-ibc-demo/testing/demo/pkg/ethereum"
 	"github.com/celestiaorg/celestia-zkevm-ibc-demo/testing/demo/pkg/utils"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	"github.com/cosmos/solidity-ibc-eureka/abigen/ics20transfer"
-	"github.com/cosmos/solidity-ibc-eureka/abigen/ics26router"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -135,19 +135,6 @@ func getIBCERC20Address() (ethcommon.Address, error) {
 	return ibcERC20Address, nil
 }
 
-// From https://medium.com/@zhuytt4/verify-the-owner-of-safe-wallet-with-eth-getproof-7edc450504ff
-func GetCommitmentsStorageKey(path []byte) ethcommon.Hash {
-	commitmentStorageSlot := ethcommon.FromHex(ics26router.IbcStoreStorageSlot)
-
-	pathHash := crypto.Keccak256(path)
-
-	// zero pad to 32 bytes
-	paddedSlot := ethcommon.LeftPadBytes(commitmentStorageSlot, 32)
-
-	// keccak256(h(k) . slot)
-	return crypto.Keccak256Hash(pathHash, paddedSlot)
-}
-
 // packetCommitmentPath returns the path of the packet commitment on the EVM chain.
 func packetCommitmentPath(clientId string, sequence uint64) []byte {
 	// Convert sequence to big endian bytes (8 bytes)
@@ -181,7 +168,7 @@ func GetEvmEvent[T any](receipt *ethtypes.Receipt, parseFn func(log ethtypes.Log
 
 // getMPTProof queries the Reth node for a Merkle Patricia Trie proof for a given key
 func getMPTProof(packetCommitmentPath []byte, contractAddress string, evmTransferBlockNumber uint64) (MptProof, error) {
-	commitmentsStorageKey := GetCommitmentsStorageKey(packetCommitmentPath)
+	commitmentsStorageKey := groth16.CommitmentStorageKey(packetCommitmentPath)
 
 	client, err := ethclient.Dial(ethereumRPC)
 	if err != nil {
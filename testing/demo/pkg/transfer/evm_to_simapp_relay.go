@@ -1,9 +1,9 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
 
+	"github.com/celestiaorg/celestia-zkevm-ibc-demo/ibc/lightclients/groth16"
 	"github.com/celestiaorg/celestia-zkevm-ibc-demo/testing/demo/pkg/utils"
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	ibcchanneltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
@@ -52,7 +52,12 @@ func createMsgRecvPacket(event *ics26router.ContractSendPacket, proof MptProof,
 		},
 	}
 
-	serializedProof, err := json.Marshal(proof)
+	jsonProof := groth16.MptProofJSON(proof)
+	protoProof, err := jsonProof.ToProto()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert proof: %w", err)
+	}
+	serializedProof, err := groth16.EncodeMptProof(protoProof)
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize proof: %w", err)
 	}
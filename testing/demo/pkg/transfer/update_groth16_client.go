@@ -1,9 +1,7 @@
 package main
 
 import (
-	"bytes"
 	"context"
-	"encoding/binary"
 	"fmt"
 	"math/big"
 	"time"
@@ -17,7 +15,6 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func updateGroth16LightClient(evmTransferBlockNumber uint64) error {
@@ -73,43 +70,6 @@ func updateGroth16LightClient(evmTransferBlockNumber uint64) error {
 	return nil
 }
 
-// DecodePublicValues decodes the public values from the Blevm proof aggregator.
-// The public values are encoded using bincode serialization. They're encoded in fixed order.
-// All of the fields are fixed size bytes32 and are encoded in little endian format.
-// Celestia header hashes is not a fixed size array therefore it is encoded
-// as a u64 length prefix(bytes8) followed by the hashes.
-func DecodePublicValues(data []byte) (*groth16.BlevmAggOutput, error) {
-	buf := bytes.NewBuffer(data)
-	output := &groth16.BlevmAggOutput{}
-
-	// Read fixed-size fields they should be 32 bytes each
-	if err := binary.Read(buf, binary.LittleEndian, &output.NewestHeaderHash); err != nil {
-		return nil, fmt.Errorf("read newest header hash: %w", err)
-	}
-
-	if err := binary.Read(buf, binary.LittleEndian, &output.OldestHeaderHash); err != nil {
-		return nil, fmt.Errorf("read oldest header hash: %w", err)
-	}
-
-	// Celestia header hashes are of variable length but bincode serialization
-	// pefixes them with a u64(8 bytes) length. We slice the 32 bytes times the length.
-	celestiaHeaderHashesLength := binary.LittleEndian.Uint64(data[64 : 64+8])
-	output.CelestiaHeaderHashes = make([][]byte, celestiaHeaderHashesLength)
-
-	var currentIndex = 64 + 8 // first two fixed length hashes and length bytes
-	for i := 0; uint64(i) < celestiaHeaderHashesLength; i++ {
-		output.CelestiaHeaderHashes[i] = []byte(data[currentIndex : currentIndex+32])
-		currentIndex += 32
-	}
-
-	// Read remaining fixed size fields
-	output.NewestStateRoot = [32]byte(data[currentIndex : currentIndex+32])
-	output.NewestHeight = binary.LittleEndian.Uint64(data[len(data)-8:])
-
-	fmt.Printf("Successfully decoded public values with %d celestia header hashes\n", len(output.CelestiaHeaderHashes))
-	return output, nil
-}
-
 func getHeader(evmTransferBlockNumber uint64) (*groth16.Header, error) {
 	resp, err := getProof()
 	if err != nil {
@@ -121,23 +81,17 @@ func getHeader(evmTransferBlockNumber uint64) (*groth16.Header, error) {
 		return nil, fmt.Errorf("failed to get trusted height: %w", err)
 	}
 
-	blevmPublicOutput, err := DecodePublicValues(resp.GetPublicValues())
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode public values: %w", err)
-	}
-
 	timestamp, err := getEVMTimestampAtHeight(evmTransferBlockNumber)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get evm timestamp at height: %w", err)
 	}
 
-	header := &groth16.Header{
-		StateTransitionProof: resp.Proof,
-		PublicValues:         resp.GetPublicValues(),
-		TrustedHeight:        trustedHeight,
-		NewestStateRoot:      blevmPublicOutput.NewestStateRoot[:],
-		NewestHeight:         blevmPublicOutput.NewestHeight,
-		Timestamp:            timestamppb.New(timestamp),
+	header, err := groth16.NewHeader(resp.Proof, resp.GetPublicValues(), trustedHeight.GetRevisionNumber(), timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build header: %w", err)
+	}
+	if header.TrustedHeight.GetRevisionHeight() != trustedHeight.GetRevisionHeight() {
+		return nil, fmt.Errorf("proof starts after height %d but the client is at height %s", header.TrustedHeight.GetRevisionHeight(), trustedHeight)
 	}
 
 	return header, nil
@@ -162,15 +116,14 @@ func getProof() (*proverclient.ProveStateTransitionResponse, error) {
 }
 
 // getTrustedHeight returns the last trusted height that the Groth16 light client is aware of.
-func getTrustedHeight() (int64, error) {
+func getTrustedHeight() (exported.Height, error) {
 	clientState, err := getClientState()
 	if err != nil {
-		return 0, fmt.Errorf("failed to get groth16 client state: %w", err)
+		return nil, fmt.Errorf("failed to get groth16 client state: %w", err)
 	}
 
 	// Get the latest height from the client state
-	height := clientState.LatestHeight
-	return int64(height), nil
+	return clientState.GetLatestClientHeight(), nil
 }
 
 func getClientState() (*groth16.ClientState, error) {